@@ -57,6 +57,13 @@ kubectl patch deployment temporal-ui -n infrastructure -p '{"spec": {"template":
 kubectl rollout status deployment/temporal-ui -n infrastructure
 
 echo "--- ЗАПУСК ПРИЛОЖЕНИЙ ---"
+# Ключ подписи котировок не хранится в репозитории: генерируем при первой установке, дальше переиспользуем
+kubectl create namespace application --dry-run=client -o yaml | kubectl apply -f -
+if ! kubectl get secret orderservice-quote-secret -n application >/dev/null 2>&1; then
+    kubectl create secret generic orderservice-quote-secret -n application \
+        --from-literal=QUOTE_SECRET="$(openssl rand -hex 32)"
+fi
+
 # Применяем твои новые конфиги (где мы разбили deployment на 3 части)
 kubectl apply -k config/application
 
@@ -111,6 +111,48 @@ func (c *EventConsumer) handle(ctx context.Context, delivery amqp.Delivery) (err
 		userID = uuid.Nil
 		message = fmt.Sprintf("Order #%s has been cancelled. Reason: %s", orderID.String(), event.Reason)
 
+	case "order_shipped":
+		var event struct {
+			OrderID        string `json:"order_id"`
+			UserID         string `json:"user_id"`
+			Carrier        string `json:"carrier"`
+			TrackingNumber string `json:"tracking_number"`
+		}
+		if err = json.Unmarshal(delivery.Body, &event); err != nil {
+			err = errors.Wrap(err, "failed to unmarshal order_shipped")
+			break
+		}
+		orderID, _ = uuid.Parse(event.OrderID)
+		userID, _ = uuid.Parse(event.UserID)
+		message = fmt.Sprintf("Order #%s has been shipped via %s. Tracking number: %s", orderID.String(), event.Carrier, event.TrackingNumber)
+
+	case "order_delivered":
+		var event struct {
+			OrderID string `json:"order_id"`
+			UserID  string `json:"user_id"`
+		}
+		if err = json.Unmarshal(delivery.Body, &event); err != nil {
+			err = errors.Wrap(err, "failed to unmarshal order_delivered")
+			break
+		}
+		orderID, _ = uuid.Parse(event.OrderID)
+		userID, _ = uuid.Parse(event.UserID)
+		message = fmt.Sprintf("Order #%s has been delivered.", orderID.String())
+
+	case "order_returned":
+		var event struct {
+			OrderID      string `json:"order_id"`
+			UserID       string `json:"user_id"`
+			RefundAmount int64  `json:"refund_amount"`
+		}
+		if err = json.Unmarshal(delivery.Body, &event); err != nil {
+			err = errors.Wrap(err, "failed to unmarshal order_returned")
+			break
+		}
+		orderID, _ = uuid.Parse(event.OrderID)
+		userID, _ = uuid.Parse(event.UserID)
+		message = fmt.Sprintf("Return for order #%s has been processed. Refunded: %d", orderID.String(), event.RefundAmount)
+
 	default:
 		l.WithField("type", delivery.Type).Info("unhandled event type")
 		return nil
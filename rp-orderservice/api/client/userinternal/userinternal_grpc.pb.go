@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.6
+// source: api/client/userinternal/userinternal.proto
+
+package userinternal
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UserInternalServiceClient is the client API for UserInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserInternalServiceClient interface {
+	StoreUser(ctx context.Context, in *StoreUserRequest, opts ...grpc.CallOption) (*StoreUserResponse, error)
+	FindUser(ctx context.Context, in *FindUserRequest, opts ...grpc.CallOption) (*FindUserResponse, error)
+}
+
+type userInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserInternalServiceClient(cc grpc.ClientConnInterface) UserInternalServiceClient {
+	return &userInternalServiceClient{cc}
+}
+
+func (c *userInternalServiceClient) StoreUser(ctx context.Context, in *StoreUserRequest, opts ...grpc.CallOption) (*StoreUserResponse, error) {
+	out := new(StoreUserResponse)
+	err := c.cc.Invoke(ctx, "/User.UserInternalService/StoreUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userInternalServiceClient) FindUser(ctx context.Context, in *FindUserRequest, opts ...grpc.CallOption) (*FindUserResponse, error) {
+	out := new(FindUserResponse)
+	err := c.cc.Invoke(ctx, "/User.UserInternalService/FindUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserInternalServiceServer is the server API for UserInternalService service.
+// All implementations must embed UnimplementedUserInternalServiceServer
+// for forward compatibility
+type UserInternalServiceServer interface {
+	StoreUser(context.Context, *StoreUserRequest) (*StoreUserResponse, error)
+	FindUser(context.Context, *FindUserRequest) (*FindUserResponse, error)
+	mustEmbedUnimplementedUserInternalServiceServer()
+}
+
+// UnimplementedUserInternalServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserInternalServiceServer struct {
+}
+
+func (UnimplementedUserInternalServiceServer) StoreUser(context.Context, *StoreUserRequest) (*StoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreUser not implemented")
+}
+func (UnimplementedUserInternalServiceServer) FindUser(context.Context, *FindUserRequest) (*FindUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUser not implemented")
+}
+func (UnimplementedUserInternalServiceServer) mustEmbedUnimplementedUserInternalServiceServer() {}
+
+// UnsafeUserInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserInternalServiceServer will
+// result in compilation errors.
+type UnsafeUserInternalServiceServer interface {
+	mustEmbedUnimplementedUserInternalServiceServer()
+}
+
+func RegisterUserInternalServiceServer(s grpc.ServiceRegistrar, srv UserInternalServiceServer) {
+	s.RegisterService(&UserInternalService_ServiceDesc, srv)
+}
+
+func _UserInternalService_StoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserInternalServiceServer).StoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/User.UserInternalService/StoreUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserInternalServiceServer).StoreUser(ctx, req.(*StoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserInternalService_FindUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserInternalServiceServer).FindUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/User.UserInternalService/FindUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserInternalServiceServer).FindUser(ctx, req.(*FindUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserInternalService_ServiceDesc is the grpc.ServiceDesc for UserInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "User.UserInternalService",
+	HandlerType: (*UserInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StoreUser",
+			Handler:    _UserInternalService_StoreUser_Handler,
+		},
+		{
+			MethodName: "FindUser",
+			Handler:    _UserInternalService_FindUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/client/userinternal/userinternal.proto",
+}
@@ -23,7 +23,35 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderInternalServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error)
+	Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*ReorderResponse, error)
 	FindOrder(ctx context.Context, in *FindOrderRequest, opts ...grpc.CallOption) (*FindOrderResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	GetOrderProgress(ctx context.Context, in *GetOrderProgressRequest, opts ...grpc.CallOption) (*GetOrderProgressResponse, error)
+	MarkShipped(ctx context.Context, in *MarkShippedRequest, opts ...grpc.CallOption) (*MarkShippedResponse, error)
+	MarkDelivered(ctx context.Context, in *MarkDeliveredRequest, opts ...grpc.CallOption) (*MarkDeliveredResponse, error)
+	ApproveOrder(ctx context.Context, in *ApproveOrderRequest, opts ...grpc.CallOption) (*ApproveOrderResponse, error)
+	RejectOrder(ctx context.Context, in *RejectOrderRequest, opts ...grpc.CallOption) (*RejectOrderResponse, error)
+	ListAwaitingApproval(ctx context.Context, in *ListAwaitingApprovalRequest, opts ...grpc.CallOption) (*ListAwaitingApprovalResponse, error)
+	AddItem(ctx context.Context, in *AddItemRequest, opts ...grpc.CallOption) (*AddItemResponse, error)
+	UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest, opts ...grpc.CallOption) (*UpdateQuantityResponse, error)
+	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*RemoveItemResponse, error)
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error)
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error)
+	ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*ApproveReturnResponse, error)
+	RejectReturn(ctx context.Context, in *RejectReturnRequest, opts ...grpc.CallOption) (*RejectReturnResponse, error)
+	FindReturn(ctx context.Context, in *FindReturnRequest, opts ...grpc.CallOption) (*FindReturnResponse, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error)
+	GetRevenue(ctx context.Context, in *GetRevenueRequest, opts ...grpc.CallOption) (*GetRevenueResponse, error)
+	GetTopProducts(ctx context.Context, in *GetTopProductsRequest, opts ...grpc.CallOption) (*GetTopProductsResponse, error)
+	GetOrderStatusCounts(ctx context.Context, in *GetOrderStatusCountsRequest, opts ...grpc.CallOption) (*GetOrderStatusCountsResponse, error)
+	CreateRecurringOrder(ctx context.Context, in *CreateRecurringOrderRequest, opts ...grpc.CallOption) (*CreateRecurringOrderResponse, error)
+	PauseRecurringOrder(ctx context.Context, in *PauseRecurringOrderRequest, opts ...grpc.CallOption) (*PauseRecurringOrderResponse, error)
+	ResumeRecurringOrder(ctx context.Context, in *ResumeRecurringOrderRequest, opts ...grpc.CallOption) (*ResumeRecurringOrderResponse, error)
+	CancelRecurringOrder(ctx context.Context, in *CancelRecurringOrderRequest, opts ...grpc.CallOption) (*CancelRecurringOrderResponse, error)
+	FindRecurringOrder(ctx context.Context, in *FindRecurringOrderRequest, opts ...grpc.CallOption) (*FindRecurringOrderResponse, error)
 }
 
 type orderInternalServiceClient struct {
@@ -43,6 +71,24 @@ func (c *orderInternalServiceClient) CreateOrder(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *orderInternalServiceClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error) {
+	out := new(QuoteOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/QuoteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*ReorderResponse, error) {
+	out := new(ReorderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/Reorder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderInternalServiceClient) FindOrder(ctx context.Context, in *FindOrderRequest, opts ...grpc.CallOption) (*FindOrderResponse, error) {
 	out := new(FindOrderResponse)
 	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/FindOrder", in, out, opts...)
@@ -52,12 +98,274 @@ func (c *orderInternalServiceClient) FindOrder(ctx context.Context, in *FindOrde
 	return out, nil
 }
 
+func (c *orderInternalServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/ListOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) GetOrderProgress(ctx context.Context, in *GetOrderProgressRequest, opts ...grpc.CallOption) (*GetOrderProgressResponse, error) {
+	out := new(GetOrderProgressResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/GetOrderProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) MarkShipped(ctx context.Context, in *MarkShippedRequest, opts ...grpc.CallOption) (*MarkShippedResponse, error) {
+	out := new(MarkShippedResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/MarkShipped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) MarkDelivered(ctx context.Context, in *MarkDeliveredRequest, opts ...grpc.CallOption) (*MarkDeliveredResponse, error) {
+	out := new(MarkDeliveredResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/MarkDelivered", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) ApproveOrder(ctx context.Context, in *ApproveOrderRequest, opts ...grpc.CallOption) (*ApproveOrderResponse, error) {
+	out := new(ApproveOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/ApproveOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) RejectOrder(ctx context.Context, in *RejectOrderRequest, opts ...grpc.CallOption) (*RejectOrderResponse, error) {
+	out := new(RejectOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/RejectOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) ListAwaitingApproval(ctx context.Context, in *ListAwaitingApprovalRequest, opts ...grpc.CallOption) (*ListAwaitingApprovalResponse, error) {
+	out := new(ListAwaitingApprovalResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/ListAwaitingApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) AddItem(ctx context.Context, in *AddItemRequest, opts ...grpc.CallOption) (*AddItemResponse, error) {
+	out := new(AddItemResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/AddItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) UpdateQuantity(ctx context.Context, in *UpdateQuantityRequest, opts ...grpc.CallOption) (*UpdateQuantityResponse, error) {
+	out := new(UpdateQuantityResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/UpdateQuantity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*RemoveItemResponse, error) {
+	out := new(RemoveItemResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/RemoveItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/GetCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error) {
+	out := new(CheckoutResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/Checkout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error) {
+	out := new(RequestReturnResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/RequestReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*ApproveReturnResponse, error) {
+	out := new(ApproveReturnResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/ApproveReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) RejectReturn(ctx context.Context, in *RejectReturnRequest, opts ...grpc.CallOption) (*RejectReturnResponse, error) {
+	out := new(RejectReturnResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/RejectReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) FindReturn(ctx context.Context, in *FindReturnRequest, opts ...grpc.CallOption) (*FindReturnResponse, error) {
+	out := new(FindReturnResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/FindReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error) {
+	out := new(CreatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) GetRevenue(ctx context.Context, in *GetRevenueRequest, opts ...grpc.CallOption) (*GetRevenueResponse, error) {
+	out := new(GetRevenueResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/GetRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) GetTopProducts(ctx context.Context, in *GetTopProductsRequest, opts ...grpc.CallOption) (*GetTopProductsResponse, error) {
+	out := new(GetTopProductsResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/GetTopProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) GetOrderStatusCounts(ctx context.Context, in *GetOrderStatusCountsRequest, opts ...grpc.CallOption) (*GetOrderStatusCountsResponse, error) {
+	out := new(GetOrderStatusCountsResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/GetOrderStatusCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) CreateRecurringOrder(ctx context.Context, in *CreateRecurringOrderRequest, opts ...grpc.CallOption) (*CreateRecurringOrderResponse, error) {
+	out := new(CreateRecurringOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/CreateRecurringOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) PauseRecurringOrder(ctx context.Context, in *PauseRecurringOrderRequest, opts ...grpc.CallOption) (*PauseRecurringOrderResponse, error) {
+	out := new(PauseRecurringOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/PauseRecurringOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) ResumeRecurringOrder(ctx context.Context, in *ResumeRecurringOrderRequest, opts ...grpc.CallOption) (*ResumeRecurringOrderResponse, error) {
+	out := new(ResumeRecurringOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/ResumeRecurringOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) CancelRecurringOrder(ctx context.Context, in *CancelRecurringOrderRequest, opts ...grpc.CallOption) (*CancelRecurringOrderResponse, error) {
+	out := new(CancelRecurringOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/CancelRecurringOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderInternalServiceClient) FindRecurringOrder(ctx context.Context, in *FindRecurringOrderRequest, opts ...grpc.CallOption) (*FindRecurringOrderResponse, error) {
+	out := new(FindRecurringOrderResponse)
+	err := c.cc.Invoke(ctx, "/Order.OrderInternalService/FindRecurringOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderInternalServiceServer is the server API for OrderInternalService service.
 // All implementations must embed UnimplementedOrderInternalServiceServer
 // for forward compatibility
 type OrderInternalServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error)
+	Reorder(context.Context, *ReorderRequest) (*ReorderResponse, error)
 	FindOrder(context.Context, *FindOrderRequest) (*FindOrderResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	GetOrderProgress(context.Context, *GetOrderProgressRequest) (*GetOrderProgressResponse, error)
+	MarkShipped(context.Context, *MarkShippedRequest) (*MarkShippedResponse, error)
+	MarkDelivered(context.Context, *MarkDeliveredRequest) (*MarkDeliveredResponse, error)
+	ApproveOrder(context.Context, *ApproveOrderRequest) (*ApproveOrderResponse, error)
+	RejectOrder(context.Context, *RejectOrderRequest) (*RejectOrderResponse, error)
+	ListAwaitingApproval(context.Context, *ListAwaitingApprovalRequest) (*ListAwaitingApprovalResponse, error)
+	AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error)
+	UpdateQuantity(context.Context, *UpdateQuantityRequest) (*UpdateQuantityResponse, error)
+	RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error)
+	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
+	Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error)
+	RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error)
+	ApproveReturn(context.Context, *ApproveReturnRequest) (*ApproveReturnResponse, error)
+	RejectReturn(context.Context, *RejectReturnRequest) (*RejectReturnResponse, error)
+	FindReturn(context.Context, *FindReturnRequest) (*FindReturnResponse, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error)
+	GetRevenue(context.Context, *GetRevenueRequest) (*GetRevenueResponse, error)
+	GetTopProducts(context.Context, *GetTopProductsRequest) (*GetTopProductsResponse, error)
+	GetOrderStatusCounts(context.Context, *GetOrderStatusCountsRequest) (*GetOrderStatusCountsResponse, error)
+	CreateRecurringOrder(context.Context, *CreateRecurringOrderRequest) (*CreateRecurringOrderResponse, error)
+	PauseRecurringOrder(context.Context, *PauseRecurringOrderRequest) (*PauseRecurringOrderResponse, error)
+	ResumeRecurringOrder(context.Context, *ResumeRecurringOrderRequest) (*ResumeRecurringOrderResponse, error)
+	CancelRecurringOrder(context.Context, *CancelRecurringOrderRequest) (*CancelRecurringOrderResponse, error)
+	FindRecurringOrder(context.Context, *FindRecurringOrderRequest) (*FindRecurringOrderResponse, error)
 	mustEmbedUnimplementedOrderInternalServiceServer()
 }
 
@@ -68,9 +376,93 @@ type UnimplementedOrderInternalServiceServer struct {
 func (UnimplementedOrderInternalServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderInternalServiceServer) QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) Reorder(context.Context, *ReorderRequest) (*ReorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reorder not implemented")
+}
 func (UnimplementedOrderInternalServiceServer) FindOrder(context.Context, *FindOrderRequest) (*FindOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindOrder not implemented")
 }
+func (UnimplementedOrderInternalServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) GetOrderProgress(context.Context, *GetOrderProgressRequest) (*GetOrderProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderProgress not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) MarkShipped(context.Context, *MarkShippedRequest) (*MarkShippedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkShipped not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) MarkDelivered(context.Context, *MarkDeliveredRequest) (*MarkDeliveredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDelivered not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) ApproveOrder(context.Context, *ApproveOrderRequest) (*ApproveOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) RejectOrder(context.Context, *RejectOrderRequest) (*RejectOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) ListAwaitingApproval(context.Context, *ListAwaitingApprovalRequest) (*ListAwaitingApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAwaitingApproval not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItem not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) UpdateQuantity(context.Context, *UpdateQuantityRequest) (*UpdateQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuantity not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveItem not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) ApproveReturn(context.Context, *ApproveReturnRequest) (*ApproveReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReturn not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) RejectReturn(context.Context, *RejectReturnRequest) (*RejectReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReturn not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) FindReturn(context.Context, *FindReturnRequest) (*FindReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindReturn not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) GetRevenue(context.Context, *GetRevenueRequest) (*GetRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenue not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) GetTopProducts(context.Context, *GetTopProductsRequest) (*GetTopProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopProducts not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) GetOrderStatusCounts(context.Context, *GetOrderStatusCountsRequest) (*GetOrderStatusCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatusCounts not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) CreateRecurringOrder(context.Context, *CreateRecurringOrderRequest) (*CreateRecurringOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) PauseRecurringOrder(context.Context, *PauseRecurringOrderRequest) (*PauseRecurringOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRecurringOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) ResumeRecurringOrder(context.Context, *ResumeRecurringOrderRequest) (*ResumeRecurringOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRecurringOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) CancelRecurringOrder(context.Context, *CancelRecurringOrderRequest) (*CancelRecurringOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringOrder not implemented")
+}
+func (UnimplementedOrderInternalServiceServer) FindRecurringOrder(context.Context, *FindRecurringOrderRequest) (*FindRecurringOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRecurringOrder not implemented")
+}
 func (UnimplementedOrderInternalServiceServer) mustEmbedUnimplementedOrderInternalServiceServer() {}
 
 // UnsafeOrderInternalServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -102,6 +494,42 @@ func _OrderInternalService_CreateOrder_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderInternalService_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/QuoteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).QuoteOrder(ctx, req.(*QuoteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_Reorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).Reorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/Reorder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).Reorder(ctx, req.(*ReorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderInternalService_FindOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindOrderRequest)
 	if err := dec(in); err != nil {
@@ -120,20 +548,600 @@ func _OrderInternalService_FindOrder_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// OrderInternalService_ServiceDesc is the grpc.ServiceDesc for OrderInternalService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var OrderInternalService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "Order.OrderInternalService",
-	HandlerType: (*OrderInternalServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateOrder",
-			Handler:    _OrderInternalService_CreateOrder_Handler,
-		},
-		{
-			MethodName: "FindOrder",
-			Handler:    _OrderInternalService_FindOrder_Handler,
+func _OrderInternalService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_GetOrderProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).GetOrderProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/GetOrderProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).GetOrderProgress(ctx, req.(*GetOrderProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_MarkShipped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkShippedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).MarkShipped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/MarkShipped",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).MarkShipped(ctx, req.(*MarkShippedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_MarkDelivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkDeliveredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).MarkDelivered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/MarkDelivered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).MarkDelivered(ctx, req.(*MarkDeliveredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_ApproveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).ApproveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/ApproveOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).ApproveOrder(ctx, req.(*ApproveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_RejectOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).RejectOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/RejectOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).RejectOrder(ctx, req.(*RejectOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_ListAwaitingApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAwaitingApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).ListAwaitingApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/ListAwaitingApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).ListAwaitingApproval(ctx, req.(*ListAwaitingApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_AddItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).AddItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/AddItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).AddItem(ctx, req.(*AddItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_UpdateQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).UpdateQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/UpdateQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).UpdateQuantity(ctx, req.(*UpdateQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_RemoveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).RemoveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/RemoveItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).RemoveItem(ctx, req.(*RemoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/GetCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/Checkout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/RequestReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_ApproveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).ApproveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/ApproveReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).ApproveReturn(ctx, req.(*ApproveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_RejectReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).RejectReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/RejectReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).RejectReturn(ctx, req.(*RejectReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_FindReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).FindReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/FindReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).FindReturn(ctx, req.(*FindReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_GetRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).GetRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/GetRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).GetRevenue(ctx, req.(*GetRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_GetTopProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).GetTopProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/GetTopProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).GetTopProducts(ctx, req.(*GetTopProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_GetOrderStatusCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderStatusCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).GetOrderStatusCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/GetOrderStatusCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).GetOrderStatusCounts(ctx, req.(*GetOrderStatusCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_CreateRecurringOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).CreateRecurringOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/CreateRecurringOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).CreateRecurringOrder(ctx, req.(*CreateRecurringOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_PauseRecurringOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRecurringOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).PauseRecurringOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/PauseRecurringOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).PauseRecurringOrder(ctx, req.(*PauseRecurringOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_ResumeRecurringOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRecurringOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).ResumeRecurringOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/ResumeRecurringOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).ResumeRecurringOrder(ctx, req.(*ResumeRecurringOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_CancelRecurringOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRecurringOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).CancelRecurringOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/CancelRecurringOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).CancelRecurringOrder(ctx, req.(*CancelRecurringOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderInternalService_FindRecurringOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRecurringOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderInternalServiceServer).FindRecurringOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Order.OrderInternalService/FindRecurringOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderInternalServiceServer).FindRecurringOrder(ctx, req.(*FindRecurringOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderInternalService_ServiceDesc is the grpc.ServiceDesc for OrderInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Order.OrderInternalService",
+	HandlerType: (*OrderInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrder",
+			Handler:    _OrderInternalService_CreateOrder_Handler,
+		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _OrderInternalService_QuoteOrder_Handler,
+		},
+		{
+			MethodName: "Reorder",
+			Handler:    _OrderInternalService_Reorder_Handler,
+		},
+		{
+			MethodName: "FindOrder",
+			Handler:    _OrderInternalService_FindOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _OrderInternalService_ListOrders_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderInternalService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderProgress",
+			Handler:    _OrderInternalService_GetOrderProgress_Handler,
+		},
+		{
+			MethodName: "MarkShipped",
+			Handler:    _OrderInternalService_MarkShipped_Handler,
+		},
+		{
+			MethodName: "MarkDelivered",
+			Handler:    _OrderInternalService_MarkDelivered_Handler,
+		},
+		{
+			MethodName: "ApproveOrder",
+			Handler:    _OrderInternalService_ApproveOrder_Handler,
+		},
+		{
+			MethodName: "RejectOrder",
+			Handler:    _OrderInternalService_RejectOrder_Handler,
+		},
+		{
+			MethodName: "ListAwaitingApproval",
+			Handler:    _OrderInternalService_ListAwaitingApproval_Handler,
+		},
+		{
+			MethodName: "AddItem",
+			Handler:    _OrderInternalService_AddItem_Handler,
+		},
+		{
+			MethodName: "UpdateQuantity",
+			Handler:    _OrderInternalService_UpdateQuantity_Handler,
+		},
+		{
+			MethodName: "RemoveItem",
+			Handler:    _OrderInternalService_RemoveItem_Handler,
+		},
+		{
+			MethodName: "GetCart",
+			Handler:    _OrderInternalService_GetCart_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _OrderInternalService_Checkout_Handler,
+		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _OrderInternalService_RequestReturn_Handler,
+		},
+		{
+			MethodName: "ApproveReturn",
+			Handler:    _OrderInternalService_ApproveReturn_Handler,
+		},
+		{
+			MethodName: "RejectReturn",
+			Handler:    _OrderInternalService_RejectReturn_Handler,
+		},
+		{
+			MethodName: "FindReturn",
+			Handler:    _OrderInternalService_FindReturn_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _OrderInternalService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetRevenue",
+			Handler:    _OrderInternalService_GetRevenue_Handler,
+		},
+		{
+			MethodName: "GetTopProducts",
+			Handler:    _OrderInternalService_GetTopProducts_Handler,
+		},
+		{
+			MethodName: "GetOrderStatusCounts",
+			Handler:    _OrderInternalService_GetOrderStatusCounts_Handler,
+		},
+		{
+			MethodName: "CreateRecurringOrder",
+			Handler:    _OrderInternalService_CreateRecurringOrder_Handler,
+		},
+		{
+			MethodName: "PauseRecurringOrder",
+			Handler:    _OrderInternalService_PauseRecurringOrder_Handler,
+		},
+		{
+			MethodName: "ResumeRecurringOrder",
+			Handler:    _OrderInternalService_ResumeRecurringOrder_Handler,
+		},
+		{
+			MethodName: "CancelRecurringOrder",
+			Handler:    _OrderInternalService_CancelRecurringOrder_Handler,
+		},
+		{
+			MethodName: "FindRecurringOrder",
+			Handler:    _OrderInternalService_FindRecurringOrder_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -39,3 +39,46 @@ type AMQP struct {
 type Temporal struct {
 	Host string `envconfig:"HOST" required:"true"`
 }
+
+// Upstream - сервисы-владельцы данных, к которым CreateOrder обращается, если проекция отстает
+type Upstream struct {
+	UserServiceAddress    string        `envconfig:"USER_SERVICE_ADDRESS" required:"true"`
+	ProductServiceAddress string        `envconfig:"PRODUCT_SERVICE_ADDRESS" required:"true"`
+	Timeout               time.Duration `envconfig:"TIMEOUT" default:"2s"`
+	BreakerFailures       int           `envconfig:"BREAKER_FAILURES" default:"5"`
+	BreakerOpenTimeout    time.Duration `envconfig:"BREAKER_OPEN_TIMEOUT" default:"30s"`
+}
+
+// Quote - подпись котировок заказа, токен действителен TTL
+type Quote struct {
+	Secret string        `envconfig:"SECRET" required:"true"`
+	TTL    time.Duration `envconfig:"TTL" default:"15m"`
+}
+
+type Saga struct {
+	PaymentWindow time.Duration `envconfig:"PAYMENT_WINDOW" default:"15m"`
+	// CompleteAfter - через сколько после доставки заказ закрывается автоматически
+	CompleteAfter time.Duration `envconfig:"COMPLETE_AFTER" default:"168h"`
+	// ApprovalThreshold - сумма в копейках, выше которой заказ ждет ручного одобрения. Ноль - без одобрения
+	ApprovalThreshold int64         `envconfig:"APPROVAL_THRESHOLD" default:"0"`
+	ApprovalTimeout   time.Duration `envconfig:"APPROVAL_TIMEOUT" default:"48h"`
+	// HoldTTL - срок холда, который сага передает в AuthorizePayment. До его конца заказ должен успеть списать деньги
+	HoldTTL time.Duration `envconfig:"HOLD_TTL" default:"72h"`
+}
+
+// Validate не дает холду истечь, пока заказ ждет пополнения и одобрения: иначе CapturePayment после позднего одобрения упадет
+func (s Saga) Validate() error {
+	if s.ApprovalThreshold <= 0 {
+		if s.PaymentWindow >= s.HoldTTL {
+			return errors.Errorf("saga: payment window %s must be less than hold TTL %s", s.PaymentWindow, s.HoldTTL)
+		}
+		return nil
+	}
+	if s.ApprovalTimeout <= 0 {
+		return errors.New("saga: approval timeout is required when approval threshold is set, the hold expires after hold TTL")
+	}
+	if s.ApprovalTimeout+s.PaymentWindow >= s.HoldTTL {
+		return errors.Errorf("saga: approval timeout %s plus payment window %s must be less than hold TTL %s", s.ApprovalTimeout, s.PaymentWindow, s.HoldTTL)
+	}
+	return nil
+}
@@ -12,16 +12,25 @@ import (
 	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/outbox"
 	"github.com/gorilla/mux"
 	"github.com/urfave/cli/v2"
+	"go.temporal.io/sdk/client"
 	"golang.org/x/sync/errgroup"
 
+	appservice "orderservice/pkg/order/application/service"
 	"orderservice/pkg/order/infrastructure/consumer"
 	"orderservice/pkg/order/infrastructure/integrationevent"
+	inframysql "orderservice/pkg/order/infrastructure/mysql"
+	"orderservice/pkg/order/infrastructure/temporal/workflowoutbox"
 )
 
+// саги не должны ждать стандартные 10 секунд outbox
+var workflowOutboxSendInterval = time.Second
+
 type messageHandlerConfig struct {
 	Service  Service  `envconfig:"service"`
 	Database Database `envconfig:"database" required:"true"`
 	AMQP     AMQP     `envconfig:"amqp" required:"true"`
+	Temporal Temporal `envconfig:"temporal" required:"true"`
+	Saga     Saga     `envconfig:"saga"`
 }
 
 func messageHandler(logger logging.Logger) *cli.Command {
@@ -33,6 +42,10 @@ func messageHandler(logger logging.Logger) *cli.Command {
 			if err != nil {
 				return err
 			}
+			err = cnf.Saga.Validate()
+			if err != nil {
+				return err
+			}
 
 			closer := libio.NewMultiCloser()
 			defer func() {
@@ -46,6 +59,17 @@ func messageHandler(logger logging.Logger) *cli.Command {
 			closer.AddCloser(databaseConnector)
 			databaseConnectionPool := mysql.NewConnectionPool(databaseConnector.TransactionalClient())
 
+			temporalClient, err := client.Dial(client.Options{
+				HostPort: cnf.Temporal.Host,
+			})
+			if err != nil {
+				return err
+			}
+			closer.AddCloser(libio.CloserFunc(func() error {
+				temporalClient.Close()
+				return nil
+			}))
+
 			amqpConnection := newAMQPConnection(cnf.AMQP, logger)
 
 			queueConfig := &amqp.QueueConfig{
@@ -68,7 +92,21 @@ func messageHandler(logger logging.Logger) *cli.Command {
 				bindConfig,
 			)
 
-			eventConsumer, err := consumer.NewEventConsumer(c.Context, amqpConnection, databaseConnectionPool, logger)
+			// неоплаченные заказы заблокированных и удаленных пользователей отменяются тем же путем, что и по запросу клиента
+			libUoW := mysql.NewUnitOfWork(databaseConnectionPool, inframysql.NewRepositoryProvider)
+			libLUow := mysql.NewLockableUnitOfWork(libUoW, mysql.NewLocker(databaseConnectionPool))
+			orderService := appservice.NewOrderService(
+				inframysql.NewUnitOfWork(libUoW),
+				inframysql.NewLockableUnitOfWork(libLUow),
+				outbox.NewEventDispatcher(appID, integrationevent.TransportName, integrationevent.NewEventSerializer(), libUoW),
+				outbox.NewEventDispatcher(appID, workflowoutbox.TransportName, workflowoutbox.NewCommandSerializer(), libUoW),
+				temporalClient,
+				nil,
+				nil,
+				0,
+			)
+
+			eventConsumer, err := consumer.NewEventConsumer(c.Context, amqpConnection, databaseConnectionPool, logger, orderService)
 			if err != nil {
 				return err
 			}
@@ -96,12 +134,27 @@ func messageHandler(logger logging.Logger) *cli.Command {
 				Logger:         logger,
 			})
 
+			workflowOutboxHandler := outbox.NewEventHandler(outbox.EventHandlerConfig{
+				TransportName: workflowoutbox.TransportName,
+				Transport: workflowoutbox.NewTransport(logger, temporalClient, cnf.Saga.PaymentWindow, cnf.Saga.HoldTTL, cnf.Saga.CompleteAfter, workflowoutbox.Approval{
+					Threshold: cnf.Saga.ApprovalThreshold,
+					Timeout:   cnf.Saga.ApprovalTimeout,
+				}),
+				ConnectionPool: databaseConnectionPool,
+				Logger:         logger,
+				SendInterval:   &workflowOutboxSendInterval,
+			})
+
 			errGroup := errgroup.Group{}
 
 			errGroup.Go(func() error {
 				return outboxEventHandler.Start(c.Context)
 			})
 
+			errGroup.Go(func() error {
+				return workflowOutboxHandler.Start(c.Context)
+			})
+
 			errGroup.Go(func() error {
 				router := mux.NewRouter()
 				registerHealthcheck(router)
@@ -16,13 +16,19 @@ import (
 	"go.temporal.io/sdk/client"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"orderservice/api/client/productinternal"
+	"orderservice/api/client/userinternal"
 	"orderservice/api/server/orderinternal"
 	appservice "orderservice/pkg/order/application/service"
 	"orderservice/pkg/order/infrastructure/integrationevent"
 	inframysql "orderservice/pkg/order/infrastructure/mysql"
 	"orderservice/pkg/order/infrastructure/mysql/query"
+	"orderservice/pkg/order/infrastructure/projectionfallback"
+	"orderservice/pkg/order/infrastructure/quote"
+	"orderservice/pkg/order/infrastructure/temporal/workflowoutbox"
 	"orderservice/pkg/order/infrastructure/transport"
 	"orderservice/pkg/order/infrastructure/transport/middlewares"
 )
@@ -31,6 +37,8 @@ type serviceConfig struct {
 	Service  Service  `envconfig:"service"`
 	Database Database `envconfig:"database" required:"true"`
 	Temporal Temporal `envconfig:"temporal" required:"true"`
+	Upstream Upstream `envconfig:"upstream" required:"true"`
+	Quote    Quote    `envconfig:"quote" required:"true"`
 }
 
 func service(logger logging.Logger) *cli.Command {
@@ -66,15 +74,54 @@ func service(logger logging.Logger) *cli.Command {
 				return nil
 			}))
 
+			userConnection, err := grpc.NewClient(cnf.Upstream.UserServiceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			closer.AddCloser(userConnection)
+			productConnection, err := grpc.NewClient(cnf.Upstream.ProductServiceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			closer.AddCloser(productConnection)
+			projectionFallback := projectionfallback.NewProjectionFallback(
+				userinternal.NewUserInternalServiceClient(userConnection),
+				productinternal.NewProductInternalServiceClient(productConnection),
+				projectionfallback.Config{
+					Timeout:          cnf.Upstream.Timeout,
+					FailureThreshold: cnf.Upstream.BreakerFailures,
+					OpenTimeout:      cnf.Upstream.BreakerOpenTimeout,
+				},
+			)
+
 			libUoW := mysql.NewUnitOfWork(databaseConnectionPool, inframysql.NewRepositoryProvider)
 			libLUow := mysql.NewLockableUnitOfWork(libUoW, mysql.NewLocker(databaseConnectionPool))
 			uow := inframysql.NewUnitOfWork(libUoW)
 			luow := inframysql.NewLockableUnitOfWork(libLUow)
 			eventDispatcher := outbox.NewEventDispatcher(appID, integrationevent.TransportName, integrationevent.NewEventSerializer(), libUoW)
+			workflowDispatcher := outbox.NewEventDispatcher(appID, workflowoutbox.TransportName, workflowoutbox.NewCommandSerializer(), libUoW)
 
+			orderService := appservice.NewOrderService(
+				uow,
+				luow,
+				eventDispatcher,
+				workflowDispatcher,
+				temporalClient,
+				projectionFallback,
+				quote.NewSigner([]byte(cnf.Quote.Secret)),
+				cnf.Quote.TTL,
+			)
 			orderInternalAPI := transport.NewOrderInternalAPI(
 				query.NewOrderQueryService(databaseConnector.TransactionalClient()),
-				appservice.NewOrderService(uow, luow, eventDispatcher, temporalClient),
+				query.NewCartQueryService(databaseConnector.TransactionalClient()),
+				query.NewReturnQueryService(databaseConnector.TransactionalClient()),
+				query.NewReportingQueryService(databaseConnector.TransactionalClient()),
+				query.NewRecurringOrderQueryService(databaseConnector.TransactionalClient()),
+				orderService,
+				appservice.NewCartService(luow, orderService),
+				appservice.NewReturnService(luow, eventDispatcher, workflowDispatcher),
+				appservice.NewPromoService(luow),
+				appservice.NewRecurringOrderService(uow, luow, workflowDispatcher, orderService),
 			)
 
 			errGroup := errgroup.Group{}
@@ -5,39 +5,84 @@ import (
 	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/logging"
+	libio "gitea.xscloud.ru/xscloud/golib/pkg/common/io"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/outbox"
 	"github.com/gorilla/mux"
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 	"golang.org/x/sync/errgroup"
 
+	appservice "orderservice/pkg/order/application/service"
+	"orderservice/pkg/order/infrastructure/integrationevent"
+	inframysql "orderservice/pkg/order/infrastructure/mysql"
+	"orderservice/pkg/order/infrastructure/temporal/activity"
+	"orderservice/pkg/order/infrastructure/temporal/workflowoutbox"
 	"orderservice/pkg/order/infrastructure/temporal/workflows"
 )
 
 type workflowWorkerConfig struct {
 	Service  Service  `envconfig:"service"`
+	Database Database `envconfig:"database" required:"true"`
 	Temporal Temporal `envconfig:"temporal" required:"true"`
 }
 
 func workflowWorker(logger logging.Logger) *cli.Command {
 	return &cli.Command{
-		Name: "workflow-worker",
+		Name:   "workflow-worker",
+		Before: migrateImpl(logger),
 		Action: func(c *cli.Context) error {
 			cnf, err := parseEnvs[workflowWorkerConfig]()
 			if err != nil {
 				return err
 			}
 
+			closer := libio.NewMultiCloser()
+			defer func() {
+				_ = closer.Close()
+			}()
+
+			databaseConnector, err := newDatabaseConnector(cnf.Database)
+			if err != nil {
+				return err
+			}
+			closer.AddCloser(databaseConnector)
+			databaseConnectionPool := mysql.NewConnectionPool(databaseConnector.TransactionalClient())
+
 			temporalClient, err := client.Dial(client.Options{
 				HostPort: cnf.Temporal.Host,
 			})
 			if err != nil {
 				return err
 			}
-			defer temporalClient.Close()
+			closer.AddCloser(libio.CloserFunc(func() error {
+				temporalClient.Close()
+				return nil
+			}))
+
+			libUoW := mysql.NewUnitOfWork(databaseConnectionPool, inframysql.NewRepositoryProvider)
+			libLUow := mysql.NewLockableUnitOfWork(libUoW, mysql.NewLocker(databaseConnectionPool))
+			uow := inframysql.NewUnitOfWork(libUoW)
+			luow := inframysql.NewLockableUnitOfWork(libLUow)
+			eventDispatcher := outbox.NewEventDispatcher(appID, integrationevent.TransportName, integrationevent.NewEventSerializer(), libUoW)
+			workflowDispatcher := outbox.NewEventDispatcher(appID, workflowoutbox.TransportName, workflowoutbox.NewCommandSerializer(), libUoW)
 
-			w := worker.New(temporalClient, "orderservice_task_queue", worker.Options{})
+			orderService := appservice.NewOrderService(uow, luow, eventDispatcher, workflowDispatcher, temporalClient, nil, nil, 0)
+
+			w := worker.New(temporalClient, workflows.OrderTaskQueue, worker.Options{})
 			w.RegisterWorkflow(workflows.CreateOrderWorkflow)
+			w.RegisterWorkflow(workflows.CancelOrderWorkflow)
+			w.RegisterWorkflow(workflows.CompleteOrderWorkflow)
+			w.RegisterWorkflow(workflows.ReturnOrderWorkflow)
+			w.RegisterWorkflow(workflows.RecurringOrderWorkflow)
+
+			activities := activity.NewOrderServiceActivities(
+				orderService,
+				appservice.NewReturnService(luow, eventDispatcher, workflowDispatcher),
+				appservice.NewRecurringOrderService(uow, luow, workflowDispatcher, orderService),
+			)
+			w.RegisterActivity(activities)
 
 			errGroup := errgroup.Group{}
 			errGroup.Go(func() error {
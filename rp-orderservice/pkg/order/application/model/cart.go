@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type Cart struct {
+	UserID uuid.UUID
+	Items  []CartItem
+	// TotalPrice считается по актуальным ценам и только по доступным товарам
+	TotalPrice int64
+	UpdatedAt  int64
+}
+
+type CartItem struct {
+	ProductID uuid.UUID
+	Name      string
+	Quantity  int
+	Price     int64
+	// Available - товар есть в проекции и не удален, то есть его можно заказать
+	Available bool
+}
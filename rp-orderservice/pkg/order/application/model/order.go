@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type OrderItem struct {
 	ProductID uuid.UUID
@@ -10,6 +14,13 @@ type OrderItem struct {
 type CreateOrder struct {
 	UserID uuid.UUID
 	Items  []OrderItem
+	// IdempotencyKey - необязательный ключ клиента, повтор с ним вернет уже созданный заказ
+	IdempotencyKey string
+	PromoCode      string
+	// QuoteToken - токен из QuoteOrder: заказ создается по зафиксированным в нем ценам
+	QuoteToken string
+	// SkipPaymentWindow - не ждать пополнения баланса, если денег не хватило. Так создаются регулярные заказы
+	SkipPaymentWindow bool
 }
 
 type Order struct {
@@ -17,6 +28,50 @@ type Order struct {
 	UserID     uuid.UUID
 	Items      []OrderItem
 	TotalPrice int64
-	Status     int
-	CreatedAt  int64
+	// Discount - скидка по промокоду, TotalPrice уже за ее вычетом
+	Discount  int64
+	PromoCode string
+	Status    int
+	// Carrier и TrackingNumber заполнены после отправки заказа
+	Carrier        string
+	TrackingNumber string
+	CreatedAt      int64
+	// History заполняется только в FindOrder
+	History []StatusTransition
+}
+
+type StatusTransition struct {
+	// From - nil у записи о создании заказа
+	From       *int
+	To         int
+	Reason     string
+	Actor      string
+	OccurredAt int64
+}
+
+type ListOrders struct {
+	UserID        *uuid.UUID
+	Statuses      []int
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	MinTotalPrice *int64
+	MaxTotalPrice *int64
+	// AfterID - курсор: последний заказ предыдущей страницы
+	AfterID  *uuid.UUID
+	PageSize int
+}
+
+type OrdersPage struct {
+	Orders      []Order
+	NextAfterID *uuid.UUID
+}
+
+type OrderProgress struct {
+	OrderID uuid.UUID
+	Status  int
+	// Running - CreateOrderWorkflow еще выполняется, и Step/LastError/Attempts взяты из него
+	Running   bool
+	Step      string
+	LastError string
+	Attempts  map[string]int
 }
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+type PromoCode struct {
+	Code          string
+	Type          int
+	Value         int64
+	MinOrderValue int64
+	UsageLimit    int
+	PerUserLimit  int
+	ValidFrom     *time.Time
+	ValidTo       *time.Time
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type QuoteOrder struct {
+	UserID    uuid.UUID
+	Items     []OrderItem
+	PromoCode string
+}
+
+type Quote struct {
+	Items      []QuotedItem
+	Discount   int64
+	TotalPrice int64
+	ExpiresAt  int64
+	// Token подписан сервисом, CreateOrder с ним спишет ровно TotalPrice, пока котировка не истекла
+	Token string
+}
+
+type QuotedItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+	Price     int64
+	Discount  int64
+}
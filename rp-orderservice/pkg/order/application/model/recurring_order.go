@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateRecurringOrder struct {
+	UserID   uuid.UUID
+	Items    []OrderItem
+	Interval time.Duration
+}
+
+type RecurringOrder struct {
+	RecurringOrderID uuid.UUID
+	UserID           uuid.UUID
+	Items            []OrderItem
+	// Interval - период в секундах
+	Interval int64
+	Status   int
+	// LastFailure - ошибка последнего неудачного или пропущенного запуска
+	LastFailure string
+	CreatedAt   int64
+	UpdatedAt   int64
+	// Runs - последние запуски, новые первыми
+	Runs []RecurringOrderRun
+}
+
+type RecurringOrderRun struct {
+	RunID     uuid.UUID
+	OrderID   *uuid.UUID
+	Status    int
+	Error     string
+	CreatedAt int64
+	UpdatedAt int64
+}
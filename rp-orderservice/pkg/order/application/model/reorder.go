@@ -0,0 +1,51 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type Reorder struct {
+	OrderID uuid.UUID
+	// Confirm - создать заказ. Без него только предпросмотр: ничего не сохраняется и сага не запускается
+	Confirm bool
+	// QuoteToken - токен из предпросмотра, обязателен для Confirm
+	QuoteToken     string
+	IdempotencyKey string
+}
+
+type DropReason int
+
+const (
+	DropReasonProductDeleted DropReason = iota
+	DropReasonProductUnavailable
+)
+
+type ReorderResult struct {
+	// OrderID - созданный заказ, uuid.Nil при предпросмотре. При подтверждении остальные поля пустые
+	OrderID uuid.UUID
+	Items   []ReorderedItem
+	Dropped []DroppedItem
+	// TotalPrice - сумма по текущим ценам без промокода
+	TotalPrice int64
+	// QuoteToken и ExpiresAt - котировка предпросмотра, с ней Confirm создаст заказ ровно на TotalPrice.
+	// Пустые, если заказывать нечего
+	QuoteToken string
+	ExpiresAt  int64
+}
+
+type ReorderedItem struct {
+	ProductID     uuid.UUID
+	Quantity      int
+	PreviousPrice int64
+	Price         int64
+}
+
+func (i ReorderedItem) PriceChanged() bool {
+	return i.Price != i.PreviousPrice
+}
+
+type DroppedItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+	Reason    DropReason
+}
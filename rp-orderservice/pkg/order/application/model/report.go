@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportPeriod - диапазон дней отчета, обе границы включительно. Дни считаются по UTC
+type ReportPeriod struct {
+	From time.Time
+	To   time.Time
+}
+
+type ReportGranularity int
+
+const (
+	GranularityDay ReportGranularity = iota
+	// GranularityWeek - недели с понедельника
+	GranularityWeek
+)
+
+type RevenueReport struct {
+	Period      ReportPeriod
+	Granularity ReportGranularity
+}
+
+type RevenuePoint struct {
+	// PeriodStart - начало дня или недели
+	PeriodStart    int64
+	PaidOrders     int
+	Revenue        int64
+	RefundedOrders int
+	Refunds        int64
+}
+
+type ProductSalesOrder int
+
+const (
+	OrderByQuantity ProductSalesOrder = iota
+	OrderByRevenue
+)
+
+type TopProductsReport struct {
+	Period  ReportPeriod
+	OrderBy ProductSalesOrder
+	Limit   int
+}
+
+// ProductSales - продажи товара за период за вычетом возвратов
+type ProductSales struct {
+	ProductID uuid.UUID
+	Quantity  int
+	Revenue   int64
+}
+
+type StatusCount struct {
+	Status int
+	Orders int
+}
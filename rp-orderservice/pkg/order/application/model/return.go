@@ -0,0 +1,17 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type Return struct {
+	ReturnID     uuid.UUID
+	OrderID      uuid.UUID
+	UserID       uuid.UUID
+	Items        []OrderItem
+	RefundAmount int64
+	Status       int
+	Reason       string
+	CreatedAt    int64
+	UpdatedAt    int64
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StartCreateOrderWorkflow - команда на запуск саги заказа.
+// Пишется в outbox в одной транзакции с заказом, workflow запускает message-handler
+type StartCreateOrderWorkflow struct {
+	OrderID    uuid.UUID
+	UserID     uuid.UUID
+	Items      []OrderItem
+	TotalPrice int64
+	// SkipPaymentWindow - при нехватке денег заказ отменяется сразу, без ожидания пополнения
+	SkipPaymentWindow bool
+}
+
+func (c StartCreateOrderWorkflow) Type() string {
+	return "start_create_order_workflow"
+}
+
+// StartCompleteOrderWorkflow - команда на запуск таймера автозавершения доставленного заказа
+type StartCompleteOrderWorkflow struct {
+	OrderID uuid.UUID
+}
+
+func (c StartCompleteOrderWorkflow) Type() string {
+	return "start_complete_order_workflow"
+}
+
+// StartReturnOrderWorkflow - команда на запуск обработки одобренного возврата
+type StartReturnOrderWorkflow struct {
+	ReturnID     uuid.UUID
+	OrderID      uuid.UUID
+	UserID       uuid.UUID
+	Items        []OrderItem
+	RefundAmount int64
+}
+
+func (c StartReturnOrderWorkflow) Type() string {
+	return "start_return_order_workflow"
+}
+
+// SyncRecurringOrderSchedule - команда привести расписание регулярного заказа к его состоянию:
+// создать, поставить на паузу, снять с паузы или удалить при отмене
+type SyncRecurringOrderSchedule struct {
+	RecurringOrderID uuid.UUID
+	Interval         time.Duration
+	Paused           bool
+	Cancelled        bool
+}
+
+func (c SyncRecurringOrderSchedule) Type() string {
+	return "sync_recurring_order_schedule"
+}
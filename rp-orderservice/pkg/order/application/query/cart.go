@@ -0,0 +1,14 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	appmodel "orderservice/pkg/order/application/model"
+)
+
+type CartQueryService interface {
+	// GetCart возвращает корзину с живыми ценами; корзины нет - вернется пустая
+	GetCart(ctx context.Context, userID uuid.UUID) (appmodel.Cart, error)
+}
@@ -10,4 +10,5 @@ import (
 
 type OrderQueryService interface {
 	FindOrder(ctx context.Context, orderID uuid.UUID) (*appmodel.Order, error)
+	ListOrders(ctx context.Context, spec appmodel.ListOrders) (appmodel.OrdersPage, error)
 }
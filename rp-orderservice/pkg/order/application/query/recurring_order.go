@@ -0,0 +1,13 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	appmodel "orderservice/pkg/order/application/model"
+)
+
+type RecurringOrderQueryService interface {
+	FindRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) (*appmodel.RecurringOrder, error)
+}
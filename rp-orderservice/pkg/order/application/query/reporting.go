@@ -0,0 +1,14 @@
+package query
+
+import (
+	"context"
+
+	appmodel "orderservice/pkg/order/application/model"
+)
+
+// ReportingQueryService читает агрегаты отчетов, сами заказы не сканируются
+type ReportingQueryService interface {
+	Revenue(ctx context.Context, report appmodel.RevenueReport) ([]appmodel.RevenuePoint, error)
+	TopProducts(ctx context.Context, report appmodel.TopProductsReport) ([]appmodel.ProductSales, error)
+	OrderStatusCounts(ctx context.Context, period appmodel.ReportPeriod) ([]appmodel.StatusCount, error)
+}
@@ -0,0 +1,13 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	appmodel "orderservice/pkg/order/application/model"
+)
+
+type ReturnQueryService interface {
+	FindReturn(ctx context.Context, returnID uuid.UUID) (*appmodel.Return, error)
+}
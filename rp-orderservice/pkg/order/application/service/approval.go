@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/temporal/workflows"
+)
+
+func (s *orderService) SetAwaitingApproval(ctx context.Context, orderID uuid.UUID) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).MarkAsAwaitingApproval(orderID)
+	})
+}
+
+// ApproveOrder передает решение в CreateOrderWorkflow, статус заказа меняет сама сага
+func (s *orderService) ApproveOrder(ctx context.Context, orderID uuid.UUID) error {
+	err := s.checkAwaitingApproval(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	return s.temporalClient.SignalWorkflow(ctx, workflows.CreateOrderWorkflowID(orderID.String()), "", workflows.ApproveOrderSignal, nil)
+}
+
+// RejectOrder передает отказ в CreateOrderWorkflow: сага снимет резерв и отменит заказ
+func (s *orderService) RejectOrder(ctx context.Context, orderID uuid.UUID, reason string, actor model.Actor) error {
+	err := s.checkAwaitingApproval(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	return s.temporalClient.SignalWorkflow(ctx, workflows.CreateOrderWorkflowID(orderID.String()), "", workflows.RejectOrderSignal, workflows.RejectOrderRequest{
+		Reason: reason,
+		Actor:  actor,
+	})
+}
+
+func (s *orderService) checkAwaitingApproval(ctx context.Context, orderID uuid.UUID) error {
+	return s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		order, err := provider.OrderRepository(ctx).Find(orderID)
+		if err != nil {
+			return err
+		}
+		if order.Status != model.StatusAwaitingApproval {
+			return model.ErrInvalidStatus
+		}
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/domain/service"
+)
+
+type CartService interface {
+	AddItem(ctx context.Context, userID, productID uuid.UUID, quantity int) error
+	UpdateQuantity(ctx context.Context, userID, productID uuid.UUID, quantity int) error
+	RemoveItem(ctx context.Context, userID, productID uuid.UUID) error
+	// Checkout оформляет заказ из корзины и очищает ее в той же транзакции
+	Checkout(ctx context.Context, userID uuid.UUID, idempotencyKey string) (uuid.UUID, error)
+}
+
+func NewCartService(
+	luow LockableUnitOfWork,
+	orderService OrderService,
+) CartService {
+	return &cartService{
+		luow:         luow,
+		orderService: orderService,
+	}
+}
+
+type cartService struct {
+	luow         LockableUnitOfWork
+	orderService OrderService
+}
+
+func (s *cartService) AddItem(ctx context.Context, userID, productID uuid.UUID, quantity int) error {
+	return s.luow.Execute(ctx, []string{userCartLock(userID)}, func(provider RepositoryProvider) error {
+		product, err := provider.LocalProductRepository(ctx).Find(productID)
+		if err != nil {
+			return err
+		}
+		if product.DeletedAt != nil {
+			return errors.Wrap(model.ErrProductDeleted, productID.String())
+		}
+		return s.domainService(ctx, provider).AddItem(userID, productID, quantity)
+	})
+}
+
+func (s *cartService) UpdateQuantity(ctx context.Context, userID, productID uuid.UUID, quantity int) error {
+	return s.luow.Execute(ctx, []string{userCartLock(userID)}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).UpdateQuantity(userID, productID, quantity)
+	})
+}
+
+func (s *cartService) RemoveItem(ctx context.Context, userID, productID uuid.UUID) error {
+	return s.luow.Execute(ctx, []string{userCartLock(userID)}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).RemoveItem(userID, productID)
+	})
+}
+
+func (s *cartService) Checkout(ctx context.Context, userID uuid.UUID, idempotencyKey string) (uuid.UUID, error) {
+	var orderID uuid.UUID
+	err := s.luow.Execute(ctx, []string{userCartLock(userID)}, func(provider RepositoryProvider) error {
+		cart, err := provider.CartRepository(ctx).Find(userID)
+		if errors.Is(err, model.ErrCartNotFound) {
+			return model.ErrEmptyCart
+		}
+		if err != nil {
+			return err
+		}
+		if len(cart.Items) == 0 {
+			return model.ErrEmptyCart
+		}
+
+		items := make([]appmodel.OrderItem, len(cart.Items))
+		for i, item := range cart.Items {
+			items[i] = appmodel.OrderItem{
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+			}
+		}
+
+		// CreateOrder выполняется в транзакции корзины: не создался заказ - корзина остается как была
+		orderID, err = s.orderService.CreateOrder(ctx, appmodel.CreateOrder{
+			UserID:         userID,
+			Items:          items,
+			IdempotencyKey: idempotencyKey,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.domainService(ctx, provider).Clear(userID)
+	})
+	return orderID, err
+}
+
+func (s *cartService) domainService(ctx context.Context, provider RepositoryProvider) service.CartService {
+	return service.NewCartService(provider.CartRepository(ctx))
+}
+
+const baseUserCartLock = "user_cart_"
+
+func userCartLock(userID uuid.UUID) string {
+	return fmt.Sprintf("%s%s", baseUserCartLock, userID.String())
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"orderservice/pkg/order/application/model"
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+type StubCartRepo struct {
+	mock.Mock
+}
+
+func (m *StubCartRepo) Store(cart domainmodel.Cart) error {
+	return m.Called(cart).Error(0)
+}
+
+func (m *StubCartRepo) Find(userID uuid.UUID) (*domainmodel.Cart, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmodel.Cart), args.Error(1)
+}
+
+func (m *StubCartRepo) Delete(userID uuid.UUID) error {
+	return m.Called(userID).Error(0)
+}
+
+func TestCartAppService_Checkout(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("cart becomes order and is cleared", func(t *testing.T) {
+		userRepo := new(StubLocalUserRepo)
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+		prodRepo := new(StubLocalProductRepo)
+		prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+			{ProductID: productID, Price: 100},
+		}, nil)
+		orderRepo := new(StubOrderRepo)
+		orderRepo.On("NextID").Return(orderID, nil)
+		orderRepo.On("Store", mock.AnythingOfType("model.Order")).Return(nil)
+		cartRepo := new(StubCartRepo)
+		cartRepo.On("Find", userID).Return(&domainmodel.Cart{
+			UserID: userID,
+			Items:  []domainmodel.CartItem{{ProductID: productID, Quantity: 3}},
+		}, nil)
+		cartRepo.On("Delete", userID).Return(nil).Once()
+
+		provider := new(MockRepositoryProvider)
+		provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+		provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+		provider.On("CartRepository", mock.Anything).Return(cartRepo)
+		uow := &MockUnitOfWork{provider: provider}
+		luow := &PassThroughLockableUnitOfWork{provider: provider}
+		workflowDispatcher := &RecordingDispatcher{}
+
+		orderService := NewOrderService(uow, luow, &DummyDispatcher{}, workflowDispatcher, new(MockTemporalClient), nil, nil, 0)
+		id, err := NewCartService(luow, orderService).Checkout(context.Background(), userID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, orderID, id)
+		assert.Equal(t, []model.OrderItem{{ProductID: productID, Quantity: 3}},
+			workflowDispatcher.events[0].(*model.StartCreateOrderWorkflow).Items)
+		cartRepo.AssertExpectations(t)
+	})
+
+	t.Run("empty cart", func(t *testing.T) {
+		cartRepo := new(StubCartRepo)
+		cartRepo.On("Find", userID).Return(nil, domainmodel.ErrCartNotFound)
+		provider := new(MockRepositoryProvider)
+		provider.On("CartRepository", mock.Anything).Return(cartRepo)
+		luow := &PassThroughLockableUnitOfWork{provider: provider}
+
+		orderService := NewOrderService(&MockUnitOfWork{provider: provider}, luow, &DummyDispatcher{}, &DummyDispatcher{}, new(MockTemporalClient), nil, nil, 0)
+		_, err := NewCartService(luow, orderService).Checkout(context.Background(), userID, "")
+		assert.ErrorIs(t, err, domainmodel.ErrEmptyCart)
+	})
+}
@@ -2,21 +2,37 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
 
 	"orderservice/pkg/order/domain/model"
 )
 
 type DataSyncService interface {
 	SyncUser(ctx context.Context, user model.LocalUser) error
+	// UpdateUserStatus при блокировке отменяет неоплаченные заказы пользователя.
+	// Событие старше уже примененного статус не меняет
+	UpdateUserStatus(ctx context.Context, userID uuid.UUID, status model.LocalUserStatus, updatedAt time.Time) error
+	// DeleteUser отменяет неоплаченные заказы пользователя
+	DeleteUser(ctx context.Context, userID uuid.UUID, deletedAt time.Time, hard bool) error
 	SyncProduct(ctx context.Context, product model.LocalProduct) error
+	DeleteProduct(ctx context.Context, productID uuid.UUID, version int64, deletedAt time.Time) error
 }
 
-func NewDataSyncService(uow UnitOfWork) DataSyncService {
-	return &dataSyncService{uow: uow}
+func NewDataSyncService(uow UnitOfWork, luow LockableUnitOfWork, orderService OrderService) DataSyncService {
+	return &dataSyncService{
+		uow:          uow,
+		luow:         luow,
+		orderService: orderService,
+	}
 }
 
 type dataSyncService struct {
-	uow UnitOfWork
+	uow          UnitOfWork
+	luow         LockableUnitOfWork
+	orderService OrderService
 }
 
 func (s *dataSyncService) SyncUser(ctx context.Context, user model.LocalUser) error {
@@ -25,8 +41,72 @@ func (s *dataSyncService) SyncUser(ctx context.Context, user model.LocalUser) er
 	})
 }
 
+func (s *dataSyncService) UpdateUserStatus(ctx context.Context, userID uuid.UUID, status model.LocalUserStatus, updatedAt time.Time) error {
+	var unpaidOrderIDs []uuid.UUID
+	// под локом заказов пользователя: CreateOrder либо уже закоммитил заказ и мы его найдем, либо увидит блокировку
+	err := s.luow.Execute(ctx, []string{userOrdersLock(userID)}, func(provider RepositoryProvider) error {
+		userRepository := provider.LocalUserRepository(ctx)
+		err := userRepository.UpdateStatus(userID, status, updatedAt)
+		if err != nil || status != model.LocalUserBlocked {
+			return err
+		}
+		// опоздавшая блокировка не применилась, если пользователя уже разблокировали
+		user, err := userRepository.Find(userID)
+		if err != nil || user.Status != model.LocalUserBlocked {
+			return err
+		}
+		unpaidOrderIDs, err = provider.OrderRepository(ctx).FindIDsByUser(userID, model.UnpaidStatuses)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return s.cancelOrders(ctx, userID, unpaidOrderIDs, model.CancelReasonUserBlocked)
+}
+
+func (s *dataSyncService) DeleteUser(ctx context.Context, userID uuid.UUID, deletedAt time.Time, hard bool) error {
+	var unpaidOrderIDs []uuid.UUID
+	err := s.luow.Execute(ctx, []string{userOrdersLock(userID)}, func(provider RepositoryProvider) error {
+		userRepository := provider.LocalUserRepository(ctx)
+		err := userRepository.MarkDeleted(userID, deletedAt)
+		if err != nil {
+			return err
+		}
+		unpaidOrderIDs, err = provider.OrderRepository(ctx).FindIDsByUser(userID, model.UnpaidStatuses)
+		if err != nil || !hard {
+			return err
+		}
+		// заказы остаются для отчетности, а логин больше нигде не нужен
+		return userRepository.Anonymize(userID)
+	})
+	if err != nil {
+		return err
+	}
+	return s.cancelOrders(ctx, userID, unpaidOrderIDs, model.CancelReasonUserDeleted)
+}
+
 func (s *dataSyncService) SyncProduct(ctx context.Context, product model.LocalProduct) error {
 	return s.uow.Execute(ctx, func(provider RepositoryProvider) error {
 		return provider.LocalProductRepository(ctx).Store(product)
 	})
 }
+
+func (s *dataSyncService) DeleteProduct(ctx context.Context, productID uuid.UUID, version int64, deletedAt time.Time) error {
+	return s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		return provider.LocalProductRepository(ctx).MarkDeleted(productID, version, deletedAt)
+	})
+}
+
+// cancelOrders отменяет заказы тем же путем, что и клиент, но от имени системы: через сагу, если она еще идет.
+// Ошибка по одному заказу не мешает отменить остальные
+func (s *dataSyncService) cancelOrders(ctx context.Context, userID uuid.UUID, orderIDs []uuid.UUID, reason string) error {
+	var errs []error
+	for _, orderID := range orderIDs {
+		err := s.orderService.RequestCancellation(ctx, orderID, userID, reason, model.ActorSystem)
+		// статус заказа успел уйти дальше, чем позволяет отмена
+		if err != nil && !errors.Is(err, model.ErrInvalidStatus) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+// StubCancellingOrderService запоминает, какие заказы и с какой причиной отменялись
+type StubCancellingOrderService struct {
+	OrderService
+	mock.Mock
+}
+
+func (m *StubCancellingOrderService) RequestCancellation(_ context.Context, orderID, userID uuid.UUID, reason string, actor domainmodel.Actor) error {
+	return m.Called(orderID, userID, reason, actor).Error(0)
+}
+
+func TestDataSyncService_CancelsUnpaidOrders(t *testing.T) {
+	userID := uuid.New()
+	firstOrderID := uuid.New()
+	secondOrderID := uuid.New()
+	updatedAt := time.Unix(1722266000, 0)
+
+	newService := func() (DataSyncService, *StubLocalUserRepo, *StubCancellingOrderService, *PassThroughLockableUnitOfWork) {
+		provider := new(MockRepositoryProvider)
+		userRepo := new(StubLocalUserRepo)
+		orderRepo := new(StubOrderRepo)
+		provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		orderRepo.On("FindIDsByUser", userID, domainmodel.UnpaidStatuses).Return([]uuid.UUID{firstOrderID, secondOrderID}, nil)
+
+		orderService := new(StubCancellingOrderService)
+		luow := &PassThroughLockableUnitOfWork{provider: provider}
+		return NewDataSyncService(&MockUnitOfWork{provider: provider}, luow, orderService), userRepo, orderService, luow
+	}
+
+	t.Run("blocked user", func(t *testing.T) {
+		service, userRepo, orderService, luow := newService()
+		userRepo.On("UpdateStatus", userID, domainmodel.LocalUserBlocked, updatedAt).Return(nil).Once()
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID, Status: domainmodel.LocalUserBlocked}, nil).Once()
+		// второй заказ успел отправиться - это не ошибка синхронизации
+		orderService.On("RequestCancellation", firstOrderID, userID, domainmodel.CancelReasonUserBlocked, domainmodel.ActorSystem).Return(nil).Once()
+		orderService.On("RequestCancellation", secondOrderID, userID, domainmodel.CancelReasonUserBlocked, domainmodel.ActorSystem).Return(domainmodel.ErrInvalidStatus).Once()
+
+		err := service.UpdateUserStatus(context.Background(), userID, domainmodel.LocalUserBlocked, updatedAt)
+		assert.NoError(t, err)
+		// тот же лок берет CreateOrder, поэтому заказ не проскочит мимо блокировки
+		assert.Equal(t, [][]string{{userOrdersLock(userID)}}, luow.lockNames)
+		userRepo.AssertExpectations(t)
+		orderService.AssertExpectations(t)
+	})
+
+	t.Run("stale block after unblock", func(t *testing.T) {
+		service, userRepo, orderService, _ := newService()
+		staleAt := updatedAt.Add(-time.Minute)
+		userRepo.On("UpdateStatus", userID, domainmodel.LocalUserBlocked, staleAt).Return(nil).Once()
+		// более новое событие уже разблокировало пользователя, опоздавшая блокировка не применилась
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID, Status: domainmodel.LocalUserActive}, nil).Once()
+
+		err := service.UpdateUserStatus(context.Background(), userID, domainmodel.LocalUserBlocked, staleAt)
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+		orderService.AssertNotCalled(t, "RequestCancellation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("unblocked user", func(t *testing.T) {
+		service, userRepo, orderService, _ := newService()
+		userRepo.On("UpdateStatus", userID, domainmodel.LocalUserActive, updatedAt).Return(nil).Once()
+
+		err := service.UpdateUserStatus(context.Background(), userID, domainmodel.LocalUserActive, updatedAt)
+		assert.NoError(t, err)
+		orderService.AssertNotCalled(t, "RequestCancellation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleted user", func(t *testing.T) {
+		service, _, orderService, luow := newService()
+		orderService.On("RequestCancellation", firstOrderID, userID, domainmodel.CancelReasonUserDeleted, domainmodel.ActorSystem).Return(nil).Once()
+		orderService.On("RequestCancellation", secondOrderID, userID, domainmodel.CancelReasonUserDeleted, domainmodel.ActorSystem).Return(nil).Once()
+
+		err := service.DeleteUser(context.Background(), userID, time.Now(), false)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{userOrdersLock(userID)}}, luow.lockNames)
+		orderService.AssertExpectations(t)
+	})
+}
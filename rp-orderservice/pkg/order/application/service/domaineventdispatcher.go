@@ -6,13 +6,48 @@ import (
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
 
 	"orderservice/pkg/common/domain"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/domain/service"
 )
 
 type domainEventDispatcher struct {
 	ctx             context.Context
 	eventDispatcher outbox.EventDispatcher[outbox.Event]
+	// reportService и recurringOrderService - nil, если события не влияют на отчеты и регулярные заказы
+	reportService         service.ReportService
+	recurringOrderService service.RecurringOrderService
 }
 
 func (d *domainEventDispatcher) Dispatch(event domain.Event) error {
+	// агрегаты отчетов обновляются в транзакции события, поэтому не расходятся с заказами
+	if d.reportService != nil {
+		var err error
+		switch e := event.(type) {
+		case *model.OrderPaid:
+			err = d.reportService.HandleOrderPaid(*e)
+		case *model.OrderCancelled:
+			err = d.reportService.HandleOrderCancelled(*e)
+		case *model.OrderReturned:
+			err = d.reportService.HandleOrderReturned(*e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// запуск регулярного заказа закрывается по исходу саги его заказа
+	if d.recurringOrderService != nil {
+		var err error
+		switch e := event.(type) {
+		case *model.OrderPaid:
+			err = d.recurringOrderService.HandleOrderPaid(e.OrderID)
+		case *model.OrderCancelled:
+			err = d.recurringOrderService.HandleOrderCancelled(e.OrderID, e.Reason)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	return d.eventDispatcher.Dispatch(d.ctx, event)
 }
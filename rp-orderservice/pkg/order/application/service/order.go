@@ -2,11 +2,19 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 
 	"orderservice/pkg/common/domain"
@@ -18,131 +26,590 @@ import (
 
 type TemporalClient interface {
 	ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (client.WorkflowRun, error)
+	SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error
+	QueryWorkflowWithOptions(ctx context.Context, request *client.QueryWorkflowWithOptionsRequest) (*client.QueryWorkflowWithOptionsResponse, error)
+	DescribeWorkflowExecution(ctx context.Context, workflowID, runID string) (*workflowservice.DescribeWorkflowExecutionResponse, error)
 }
 
 type OrderService interface {
 	CreateOrder(ctx context.Context, order appmodel.CreateOrder) (uuid.UUID, error)
+	SetAwaitingApproval(ctx context.Context, orderID uuid.UUID) error
+	SetPaymentPending(ctx context.Context, orderID uuid.UUID) error
 	HandlePaymentResult(ctx context.Context, orderID uuid.UUID, success bool) error
+	CancelOrder(ctx context.Context, orderID uuid.UUID, reason string, actor model.Actor) error
+	CancelAfterRefund(ctx context.Context, orderID uuid.UUID, reason string, actor model.Actor) error
+	// RequestCancellation отменяет заказ от имени actor. Клиент может отменить только свой заказ, userID - его id
+	RequestCancellation(ctx context.Context, orderID, userID uuid.UUID, reason string, actor model.Actor) error
+	// ApproveOrder и RejectOrder решают судьбу заказа в статусе AwaitingApproval
+	ApproveOrder(ctx context.Context, orderID uuid.UUID) error
+	RejectOrder(ctx context.Context, orderID uuid.UUID, reason string, actor model.Actor) error
+	MarkShipped(ctx context.Context, orderID uuid.UUID, carrier, trackingNumber string) error
+	MarkDelivered(ctx context.Context, orderID uuid.UUID) error
+	CompleteOrder(ctx context.Context, orderID uuid.UUID) error
+	GetOrderProgress(ctx context.Context, orderID uuid.UUID) (appmodel.OrderProgress, error)
+	// QuoteOrder фиксирует текущие цены заказа в подписанном токене с ограниченным сроком жизни
+	QuoteOrder(ctx context.Context, quote appmodel.QuoteOrder) (appmodel.Quote, error)
+	// Reorder повторяет заказ по текущим ценам, без Confirm только показывает выпавшие позиции и изменившиеся цены
+	Reorder(ctx context.Context, request appmodel.Reorder) (appmodel.ReorderResult, error)
 }
 
 func NewOrderService(
 	uow UnitOfWork,
 	luow LockableUnitOfWork,
 	eventDispatcher outbox.EventDispatcher[outbox.Event],
+	workflowDispatcher outbox.EventDispatcher[outbox.Event],
 	temporalClient TemporalClient,
+	projectionFallback ProjectionFallback, // nil - без fallback, только локальные проекции
+	quoteSigner QuoteSigner, // nil - котировки не выдаются и не принимаются
+	quoteTTL time.Duration,
 ) OrderService {
 	return &orderService{
-		uow:             uow,
-		luow:            luow,
-		eventDispatcher: eventDispatcher,
-		temporalClient:  temporalClient,
+		uow:                uow,
+		luow:               luow,
+		eventDispatcher:    eventDispatcher,
+		workflowDispatcher: workflowDispatcher,
+		temporalClient:     temporalClient,
+		projectionFallback: projectionFallback,
+		quoteSigner:        quoteSigner,
+		quoteTTL:           quoteTTL,
 	}
 }
 
 type orderService struct {
-	uow             UnitOfWork
-	luow            LockableUnitOfWork
-	eventDispatcher outbox.EventDispatcher[outbox.Event]
-	temporalClient  TemporalClient
+	uow                UnitOfWork
+	luow               LockableUnitOfWork
+	eventDispatcher    outbox.EventDispatcher[outbox.Event]
+	workflowDispatcher outbox.EventDispatcher[outbox.Event]
+	temporalClient     TemporalClient
+	projectionFallback ProjectionFallback
+	quoteSigner        QuoteSigner
+	quoteTTL           time.Duration
 }
 
 func (s *orderService) CreateOrder(ctx context.Context, order appmodel.CreateOrder) (uuid.UUID, error) {
 	var orderID uuid.UUID
+	// повтор товара в запросе сломал бы PK order_item - складываем количество
+	order.Items = mergeOrderItems(order.Items)
+	order.PromoCode = service.NormalizePromoCode(order.PromoCode)
 
-	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
-		userRepo := provider.LocalUserRepository(ctx)
-		productRepo := provider.LocalProductRepository(ctx)
-
-		if _, err := userRepo.Find(order.UserID); err != nil {
-			return errors.Wrap(model.ErrUserNotFound, err.Error())
+	var quote *model.Quote
+	if order.QuoteToken != "" {
+		var err error
+		quote, err = s.verifyQuote(order)
+		if err != nil {
+			return uuid.Nil, err
 		}
+		order.Items = quoteOrderItems(quote)
+		order.PromoCode = quote.PromoCode
+	}
+
+	if len(order.IdempotencyKey) > model.MaxIdempotencyKeyLength {
+		return uuid.Nil, model.ErrInvalidIdempotencyKey
+	}
+	// лок пользователя не дает двум ретраям с одним ключом идемпотентности создать заказ параллельно,
+	// а блокировке пользователя - пропустить заказ, закоммиченный уже после поиска его неоплаченных заказов
+	lockNames := []string{userOrdersLock(order.UserID)}
+	if order.PromoCode != "" {
+		// лимиты кода проверяются и расходуются в одной транзакции с заказом под локом кода
+		lockNames = append(lockNames, promoCodeLock(order.PromoCode))
+	}
+
+	upstream, err := s.fetchUpstream(ctx, order.UserID, orderProductIDs(order.Items))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	create := func(provider RepositoryProvider) error {
+		var err error
+		orderID, err = s.createOrderOnce(ctx, provider, order, quote, upstream)
+		return err
+	}
+	return orderID, s.luow.Execute(ctx, lockNames, create)
+}
+
+// createOrderOnce создает заказ, а с ключом идемпотентности возвращает уже созданный по нему заказ
+func (s *orderService) createOrderOnce(
+	ctx context.Context,
+	provider RepositoryProvider,
+	order appmodel.CreateOrder,
+	quote *model.Quote,
+	upstream upstreamData,
+) (uuid.UUID, error) {
+	if order.IdempotencyKey == "" {
+		return s.createOrder(ctx, provider, order, quote, upstream)
+	}
 
-		productIDs := make([]uuid.UUID, len(order.Items))
-		for i, item := range order.Items {
-			productIDs[i] = item.ProductID
+	requestHash := createOrderRequestHash(order)
+	keyRepository := provider.IdempotencyKeyRepository(ctx)
+	key, err := keyRepository.Find(order.UserID, order.IdempotencyKey)
+	if err == nil {
+		if key.RequestHash != requestHash {
+			return uuid.Nil, model.ErrIdempotencyKeyConflict
 		}
+		return key.OrderID, nil
+	}
+	if !errors.Is(err, model.ErrIdempotencyKeyNotFound) {
+		return uuid.Nil, err
+	}
+
+	orderID, err := s.createOrder(ctx, provider, order, quote, upstream)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return orderID, keyRepository.Store(model.IdempotencyKey{
+		UserID:      order.UserID,
+		Key:         order.IdempotencyKey,
+		RequestHash: requestHash,
+		OrderID:     orderID,
+		CreatedAt:   time.Now(),
+	})
+}
 
-		products, err := productRepo.FindMany(productIDs)
+func (s *orderService) createOrder(
+	ctx context.Context,
+	provider RepositoryProvider,
+	order appmodel.CreateOrder,
+	quote *model.Quote,
+	upstream upstreamData,
+) (uuid.UUID, error) {
+	// срок котировки проверяем после поиска по ключу идемпотентности: повтор уже созданного заказа не должен падать
+	if quote != nil && quote.IsExpired(time.Now()) {
+		return uuid.Nil, model.ErrQuoteExpired
+	}
+
+	domainItems, err := s.priceItems(ctx, provider, order.UserID, order.Items, order.PromoCode, quote, upstream)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var totalPrice int64
+	for _, item := range domainItems {
+		totalPrice += item.Price*int64(item.Quantity) - item.Discount
+	}
+	if quote != nil && totalPrice != quote.TotalPrice {
+		return uuid.Nil, errors.Wrap(model.ErrInvalidQuote, "order total differs from quoted total")
+	}
+
+	domainService := s.domainService(ctx, provider)
+	orderID, err := domainService.CreateOrder(order.UserID, domainItems, order.PromoCode)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if order.PromoCode != "" {
+		err = s.promoDomainService(ctx, provider).Redeem(order.PromoCode, orderID, order.UserID)
 		if err != nil {
-			return err
+			return uuid.Nil, err
 		}
-		if len(products) != len(order.Items) {
-			return model.ErrProductNotFound
+	}
+
+	// сага стартует только если заказ закоммичен
+	err = s.workflowDispatcher.Dispatch(ctx, &appmodel.StartCreateOrderWorkflow{
+		OrderID:           orderID,
+		UserID:            order.UserID,
+		Items:             order.Items,
+		TotalPrice:        totalPrice,
+		SkipPaymentWindow: order.SkipPaymentWindow,
+	})
+	return orderID, err
+}
+
+// priceItems проверяет пользователя и товары и считает цены позиций со скидкой.
+// Цены берутся из котировки, если она есть, иначе - текущие из проекции
+func (s *orderService) priceItems(
+	ctx context.Context,
+	provider RepositoryProvider,
+	userID uuid.UUID,
+	items []appmodel.OrderItem,
+	promoCode string,
+	quote *model.Quote,
+	upstream upstreamData,
+) ([]model.OrderItem, error) {
+	userRepo := provider.LocalUserRepository(ctx)
+	productRepo := provider.LocalProductRepository(ctx)
+
+	user, err := findUser(userRepo, userID, upstream)
+	if err != nil {
+		return nil, errors.Wrap(model.ErrUserNotFound, err.Error())
+	}
+	if user.DeletedAt != nil {
+		return nil, model.ErrUserDeleted
+	}
+	if user.Status == model.LocalUserBlocked {
+		return nil, model.ErrUserBlocked
+	}
+
+	productIDs := orderProductIDs(items)
+	products, err := findProducts(productRepo, productIDs, upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uuid.UUID]int64, len(products))
+	for _, p := range products {
+		if p.DeletedAt != nil {
+			return nil, errors.Wrap(model.ErrProductDeleted, p.ProductID.String())
+		}
+		prices[p.ProductID] = p.Price
+	}
+	for _, productID := range productIDs {
+		if _, ok := prices[productID]; !ok {
+			return nil, errors.Wrap(model.ErrProductNotFound, productID.String())
 		}
+	}
+	if quote != nil {
+		for _, item := range quote.Items {
+			prices[item.ProductID] = item.Price
+		}
+	}
 
-		productMap := make(map[uuid.UUID]model.LocalProduct, len(products))
-		for _, p := range products {
-			productMap[p.ProductID] = p
+	domainItems := make([]model.OrderItem, len(items))
+	for i, item := range items {
+		domainItems[i] = model.OrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     prices[item.ProductID],
 		}
+	}
+
+	if promoCode == "" {
+		return domainItems, nil
+	}
+	return s.promoDomainService(ctx, provider).ApplyPromoCode(promoCode, userID, domainItems)
+}
+
+// findUser читает пользователя из проекции, а если его там еще нет - берет дочитанного у userservice.
+// Найденный пользователь сохраняется в проекцию, чтобы следующий заказ обошелся без запроса
+func findUser(repo model.LocalUserRepository, userID uuid.UUID, upstream upstreamData) (*model.LocalUser, error) {
+	user, err := repo.Find(userID)
+	if !errors.Is(err, model.ErrUserNotFound) || upstream.user == nil {
+		return user, err
+	}
+
+	fetched := upstream.user
+	if fetched.DeletedAt != nil {
+		err = repo.MarkDeleted(userID, *fetched.DeletedAt)
+	} else {
+		err = repo.Store(*fetched)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fetched, nil
+}
 
-		domainItems := make([]model.OrderItem, len(order.Items))
-		var wfItems []workflows.OrderItem
-		var totalPrice int64
+// findProducts дополняет товары из проекции дочитанными у productservice и сохраняет их в проекцию
+func findProducts(repo model.LocalProductRepository, productIDs []uuid.UUID, upstream upstreamData) ([]model.LocalProduct, error) {
+	products, err := repo.FindMany(productIDs)
+	if err != nil || len(upstream.products) == 0 {
+		return products, err
+	}
 
-		for i, item := range order.Items {
-			product := productMap[item.ProductID]
-			domainItems[i] = model.OrderItem{
-				ProductID: item.ProductID,
-				Quantity:  item.Quantity,
-				Price:     product.Price,
-			}
-			wfItems = append(wfItems, workflows.OrderItem{
-				ProductID: item.ProductID.String(),
-				Quantity:  item.Quantity,
-			})
-			totalPrice += product.Price * int64(item.Quantity)
+	// товар мог прийти событием, пока ждали ответа productservice - проекция тогда свежее
+	missing := make(map[uuid.UUID]struct{})
+	for _, productID := range missingProductIDs(productIDs, products) {
+		missing[productID] = struct{}{}
+	}
+	for _, product := range upstream.products {
+		if _, ok := missing[product.ProductID]; !ok {
+			continue
+		}
+		err = repo.Store(product)
+		if err != nil {
+			return nil, err
 		}
+		products = append(products, product)
+	}
+	return products, nil
+}
 
+func orderProductIDs(items []appmodel.OrderItem) []uuid.UUID {
+	productIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		productIDs[i] = item.ProductID
+	}
+	return productIDs
+}
+
+func (s *orderService) SetPaymentPending(ctx context.Context, orderID uuid.UUID) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).MarkAsPaymentPending(orderID)
+	})
+}
+
+func (s *orderService) HandlePaymentResult(ctx context.Context, orderID uuid.UUID, success bool) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
 		domainService := s.domainService(ctx, provider)
-		id, err := domainService.CreateOrder(order.UserID, domainItems)
+		if success {
+			return domainService.MarkAsPaid(orderID)
+		}
+		err := domainService.CancelOrder(orderID, "Payment failed", model.ActorWorkflow)
 		if err != nil {
 			return err
 		}
-		orderID = id
+		return s.releasePromoCode(ctx, provider, orderID)
+	})
+}
 
-		// Trigger Temporal Workflow
-		workflowOptions := client.StartWorkflowOptions{
-			ID:        "order_" + orderID.String(),
-			TaskQueue: "orderservice_task_queue",
+func (s *orderService) CancelOrder(ctx context.Context, orderID uuid.UUID, reason string, actor model.Actor) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).CancelOrder(orderID, reason, actor)
+		if err != nil {
+			return err
 		}
+		return s.releasePromoCode(ctx, provider, orderID)
+	})
+}
 
-		_, err = s.temporalClient.ExecuteWorkflow(context.Background(), workflowOptions, workflows.CreateOrderWorkflow, workflows.CreateOrderParams{
-			OrderID:    orderID.String(),
-			UserID:     order.UserID.String(),
-			Items:      wfItems,
-			TotalPrice: totalPrice,
-		})
+func (s *orderService) CancelAfterRefund(ctx context.Context, orderID uuid.UUID, reason string, actor model.Actor) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).CancelAfterRefund(orderID, reason, actor)
+		if err != nil {
+			return err
+		}
+		return s.releasePromoCode(ctx, provider, orderID)
+	})
+}
 
+// RequestCancellation передает отмену в CreateOrderWorkflow, а если он уже завершился -
+// запускает отдельный CancelOrderWorkflow с возвратом денег
+func (s *orderService) RequestCancellation(ctx context.Context, orderID, userID uuid.UUID, reason string, actor model.Actor) error {
+	var order *model.Order
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		var err error
+		order, err = provider.OrderRepository(ctx).Find(orderID)
+		return err
+	})
+	if err != nil {
 		return err
+	}
+	// чужой заказ для клиента не существует
+	if actor == model.ActorCustomer && order.UserID != userID {
+		return errors.WithStack(model.ErrOrderNotFound)
+	}
+	if order.Status == model.StatusCancelled {
+		return nil
+	}
+	// отправленный заказ уже не отменить: деньги вернули бы, а отмена не прошла бы
+	if !order.Status.CanTransitionTo(model.StatusCancelled) {
+		return model.ErrInvalidStatus
+	}
+
+	err = s.temporalClient.SignalWorkflow(ctx, workflows.CreateOrderWorkflowID(orderID.String()), "", workflows.CancelOrderSignal, workflows.CancelOrderRequest{
+		Reason: reason,
+		Actor:  actor,
 	})
+	var notFound *serviceerror.NotFound
+	if !errors.As(err, &notFound) {
+		return err
+	}
 
-	return orderID, err
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        cancelOrderWorkflowID(orderID),
+		TaskQueue: workflows.OrderTaskQueue,
+		// упавшую отмену можно перезапустить, успешную - нет
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+	}
+	_, err = s.temporalClient.ExecuteWorkflow(context.Background(), workflowOptions, workflows.CancelOrderWorkflow, workflows.CancelOrderParams{
+		OrderID:    orderID.String(),
+		UserID:     order.UserID.String(),
+		TotalPrice: order.TotalPrice,
+		Reason:     reason,
+		Actor:      actor,
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	return err
 }
 
-func (s *orderService) HandlePaymentResult(ctx context.Context, orderID uuid.UUID, success bool) error {
+func (s *orderService) MarkShipped(ctx context.Context, orderID uuid.UUID, carrier, trackingNumber string) error {
 	lockName := orderLock(orderID)
 	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
-		domainService := s.domainService(ctx, provider)
-		if success {
-			return domainService.MarkAsPaid(orderID)
+		return s.domainService(ctx, provider).MarkAsShipped(orderID, model.Shipment{
+			Carrier:        carrier,
+			TrackingNumber: trackingNumber,
+		})
+	})
+}
+
+// MarkDelivered отмечает доставку и в той же транзакции заводит таймер автозавершения заказа
+func (s *orderService) MarkDelivered(ctx context.Context, orderID uuid.UUID) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).MarkAsDelivered(orderID)
+		if err != nil {
+			return err
 		}
-		return domainService.CancelOrder(orderID, "Payment failed")
+		return s.workflowDispatcher.Dispatch(ctx, &appmodel.StartCompleteOrderWorkflow{OrderID: orderID})
+	})
+}
+
+func (s *orderService) CompleteOrder(ctx context.Context, orderID uuid.UUID) error {
+	lockName := orderLock(orderID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).Complete(orderID)
+	})
+}
+
+// GetOrderProgress спрашивает у CreateOrderWorkflow текущий шаг саги.
+// Повторы выполняющейся activity знает только сервер, их берем из описания workflow.
+// Если workflow уже закрыт, отдаем только статус заказа из базы
+func (s *orderService) GetOrderProgress(ctx context.Context, orderID uuid.UUID) (appmodel.OrderProgress, error) {
+	var order *model.Order
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		var err error
+		order, err = provider.OrderRepository(ctx).Find(orderID)
+		return err
 	})
+	if err != nil {
+		return appmodel.OrderProgress{}, err
+	}
+
+	progress := appmodel.OrderProgress{
+		OrderID: orderID,
+		Status:  int(order.Status),
+	}
+
+	response, err := s.temporalClient.QueryWorkflowWithOptions(ctx, &client.QueryWorkflowWithOptionsRequest{
+		WorkflowID:           workflows.CreateOrderWorkflowID(orderID.String()),
+		QueryType:            workflows.OrderProgressQuery,
+		QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return progress, nil
+	}
+	if err != nil {
+		return appmodel.OrderProgress{}, err
+	}
+	if response.QueryRejected != nil {
+		return progress, nil
+	}
+
+	var workflowProgress workflows.OrderProgress
+	err = response.QueryResult.Get(&workflowProgress)
+	if err != nil {
+		return appmodel.OrderProgress{}, err
+	}
+
+	description, err := s.temporalClient.DescribeWorkflowExecution(ctx, workflows.CreateOrderWorkflowID(orderID.String()), "")
+	if err != nil {
+		return appmodel.OrderProgress{}, err
+	}
+	for _, pending := range description.GetPendingActivities() {
+		if pending.GetActivityType().GetName() != workflowProgress.Activity {
+			continue
+		}
+		// первую попытку workflow уже посчитал
+		if pending.GetAttempt() > 1 {
+			workflowProgress.Attempts[workflowProgress.Step] += int(pending.GetAttempt()) - 1
+		}
+		if pending.GetLastFailure() != nil {
+			workflowProgress.LastError = pending.GetLastFailure().GetMessage()
+		}
+	}
+
+	progress.Running = true
+	progress.Step = workflowProgress.Step
+	progress.LastError = workflowProgress.LastError
+	progress.Attempts = workflowProgress.Attempts
+	return progress, nil
+}
+
+// releasePromoCode возвращает код в лимиты, если заказ отменен. Компенсация оплаченный заказ не отменяет,
+// поэтому решаем по статусу после отмены
+func (s *orderService) releasePromoCode(ctx context.Context, provider RepositoryProvider, orderID uuid.UUID) error {
+	order, err := provider.OrderRepository(ctx).Find(orderID)
+	if err != nil {
+		return err
+	}
+	if order.Status != model.StatusCancelled || order.PromoCode == "" {
+		return nil
+	}
+	return s.promoDomainService(ctx, provider).Release(orderID)
 }
 
 func (s *orderService) domainService(ctx context.Context, provider RepositoryProvider) service.OrderService {
-	return service.NewOrderService(provider.OrderRepository(ctx), s.domainEventDispatcher(ctx))
+	return service.NewOrderService(
+		provider.OrderRepository(ctx),
+		provider.StatusTransitionRepository(ctx),
+		s.domainEventDispatcher(ctx, provider),
+	)
+}
+
+func (s *orderService) promoDomainService(ctx context.Context, provider RepositoryProvider) service.PromoService {
+	return service.NewPromoService(
+		provider.PromoCodeRepository(ctx),
+		provider.PromoRedemptionRepository(ctx),
+	)
 }
 
-func (s *orderService) domainEventDispatcher(ctx context.Context) domain.EventDispatcher {
+func (s *orderService) domainEventDispatcher(ctx context.Context, provider RepositoryProvider) domain.EventDispatcher {
 	return &domainEventDispatcher{
 		ctx:             ctx,
 		eventDispatcher: s.eventDispatcher,
+		reportService: service.NewReportService(
+			provider.OrderRepository(ctx),
+			provider.ReportRepository(ctx),
+		),
+		recurringOrderService: service.NewRecurringOrderService(provider.RecurringOrderRepository(ctx)),
 	}
 }
 
+// createOrderRequestHash строит отпечаток запроса, не зависящий от порядка позиций
+func createOrderRequestHash(order appmodel.CreateOrder) string {
+	items := make([]string, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = fmt.Sprintf("%s:%d", item.ProductID, item.Quantity)
+	}
+	sort.Strings(items)
+	if order.PromoCode != "" {
+		items = append(items, "promo:"+order.PromoCode)
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(items, ";")))
+	return hex.EncodeToString(hash[:])
+}
+
+func mergeOrderItems(items []appmodel.OrderItem) []appmodel.OrderItem {
+	merged := make([]appmodel.OrderItem, 0, len(items))
+	positions := make(map[uuid.UUID]int, len(items))
+	for _, item := range items {
+		if i, ok := positions[item.ProductID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		positions[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
+
+func cancelOrderWorkflowID(orderID uuid.UUID) string {
+	return "order_cancel_" + orderID.String()
+}
+
+const baseUserOrdersLock = "user_orders_"
+
+func userOrdersLock(userID uuid.UUID) string {
+	return fmt.Sprintf("%s%s", baseUserOrdersLock, userID.String())
+}
+
 const baseOrderLock = "order_"
 
 func orderLock(id uuid.UUID) string {
 	return fmt.Sprintf("%s%s", baseOrderLock, id.String())
 }
+
+const basePromoCodeLock = "promo_code_"
+
+func promoCodeLock(code string) string {
+	return fmt.Sprintf("%s%s", basePromoCodeLock, code)
+}
@@ -2,21 +2,32 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
 
 	"orderservice/pkg/order/application/model"
 	domainmodel "orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/temporal/workflows"
 )
 
 type MockRepositoryProvider struct {
 	mock.Mock
+	// recurringOrderRepo - nil, если сценарий не касается регулярных заказов
+	recurringOrderRepo domainmodel.RecurringOrderRepository
 }
 
 func (m *MockRepositoryProvider) OrderRepository(ctx context.Context) domainmodel.OrderRepository {
@@ -24,6 +35,11 @@ func (m *MockRepositoryProvider) OrderRepository(ctx context.Context) domainmode
 	return args.Get(0).(domainmodel.OrderRepository)
 }
 
+func (m *MockRepositoryProvider) StatusTransitionRepository(ctx context.Context) domainmodel.StatusTransitionRepository {
+	args := m.Called(ctx)
+	return args.Get(0).(domainmodel.StatusTransitionRepository)
+}
+
 func (m *MockRepositoryProvider) LocalUserRepository(ctx context.Context) domainmodel.LocalUserRepository {
 	args := m.Called(ctx)
 	return args.Get(0).(domainmodel.LocalUserRepository)
@@ -34,6 +50,45 @@ func (m *MockRepositoryProvider) LocalProductRepository(ctx context.Context) dom
 	return args.Get(0).(domainmodel.LocalProductRepository)
 }
 
+func (m *MockRepositoryProvider) IdempotencyKeyRepository(ctx context.Context) domainmodel.IdempotencyKeyRepository {
+	args := m.Called(ctx)
+	return args.Get(0).(domainmodel.IdempotencyKeyRepository)
+}
+
+func (m *MockRepositoryProvider) CartRepository(ctx context.Context) domainmodel.CartRepository {
+	args := m.Called(ctx)
+	return args.Get(0).(domainmodel.CartRepository)
+}
+
+func (m *MockRepositoryProvider) ReturnRepository(ctx context.Context) domainmodel.ReturnRepository {
+	args := m.Called(ctx)
+	return args.Get(0).(domainmodel.ReturnRepository)
+}
+
+func (m *MockRepositoryProvider) PromoCodeRepository(ctx context.Context) domainmodel.PromoCodeRepository {
+	args := m.Called(ctx)
+	return args.Get(0).(domainmodel.PromoCodeRepository)
+}
+
+func (m *MockRepositoryProvider) PromoRedemptionRepository(ctx context.Context) domainmodel.PromoRedemptionRepository {
+	args := m.Called(ctx)
+	return args.Get(0).(domainmodel.PromoRedemptionRepository)
+}
+
+// ReportRepository не требует ожиданий: отчеты обновляются почти в каждом сценарии и проверяются отдельно
+func (m *MockRepositoryProvider) ReportRepository(_ context.Context) domainmodel.ReportRepository {
+	return &StubReportRepo{}
+}
+
+// RecurringOrderRepository по умолчанию не знает регулярных заказов: о каждом оплаченном или отмененном заказе
+// спрашивают, не создан ли он по расписанию
+func (m *MockRepositoryProvider) RecurringOrderRepository(_ context.Context) domainmodel.RecurringOrderRepository {
+	if m.recurringOrderRepo != nil {
+		return m.recurringOrderRepo
+	}
+	return &EmptyRecurringOrderRepo{}
+}
+
 type MockLockableUnitOfWork struct {
 	mock.Mock
 }
@@ -52,11 +107,59 @@ func (m *MockUnitOfWork) Execute(_ context.Context, f func(provider RepositoryPr
 	return f(m.provider)
 }
 
+type PassThroughLockableUnitOfWork struct {
+	provider *MockRepositoryProvider
+	// lockNames - локи всех вызовов Execute по порядку
+	lockNames [][]string
+	// inTransaction - выполняется ли сейчас f под локами
+	inTransaction bool
+}
+
+func (m *PassThroughLockableUnitOfWork) Execute(_ context.Context, lockNames []string, f func(provider RepositoryProvider) error) error {
+	m.lockNames = append(m.lockNames, lockNames)
+	m.inTransaction = true
+	defer func() {
+		m.inTransaction = false
+	}()
+	return f(m.provider)
+}
+
+type StubIdempotencyKeyRepo struct {
+	mock.Mock
+}
+
+func (m *StubIdempotencyKeyRepo) Store(key domainmodel.IdempotencyKey) error {
+	return m.Called(key).Error(0)
+}
+
+func (m *StubIdempotencyKeyRepo) Find(userID uuid.UUID, key string) (*domainmodel.IdempotencyKey, error) {
+	args := m.Called(userID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmodel.IdempotencyKey), args.Error(1)
+}
+
+type StubStatusTransitionRepo struct{}
+
+func (m *StubStatusTransitionRepo) Append(_ domainmodel.StatusTransition) error { return nil }
+
+type StubReportRepo struct{}
+
+func (m *StubReportRepo) AddDailySales(time.Time, domainmodel.DailySales) error        { return nil }
+func (m *StubReportRepo) AddProductSales(time.Time, []domainmodel.ProductSales) error  { return nil }
+func (m *StubReportRepo) AddStatusCount(time.Time, domainmodel.OrderStatus, int) error { return nil }
+
 type StubLocalUserRepo struct {
 	mock.Mock
 }
 
-func (m *StubLocalUserRepo) Store(_ domainmodel.LocalUser) error { return nil }
+func (m *StubLocalUserRepo) Store(_ domainmodel.LocalUser) error        { return nil }
+func (m *StubLocalUserRepo) MarkDeleted(_ uuid.UUID, _ time.Time) error { return nil }
+func (m *StubLocalUserRepo) Anonymize(_ uuid.UUID) error                { return nil }
+func (m *StubLocalUserRepo) UpdateStatus(id uuid.UUID, status domainmodel.LocalUserStatus, updatedAt time.Time) error {
+	return m.Called(id, status, updatedAt).Error(0)
+}
 func (m *StubLocalUserRepo) Find(id uuid.UUID) (*domainmodel.LocalUser, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
@@ -71,6 +174,9 @@ type StubLocalProductRepo struct {
 
 func (m *StubLocalProductRepo) Store(_ domainmodel.LocalProduct) error              { return nil }
 func (m *StubLocalProductRepo) Find(_ uuid.UUID) (*domainmodel.LocalProduct, error) { return nil, nil }
+func (m *StubLocalProductRepo) MarkDeleted(_ uuid.UUID, _ int64, _ time.Time) error {
+	return nil
+}
 func (m *StubLocalProductRepo) FindMany(ids []uuid.UUID) ([]domainmodel.LocalProduct, error) {
 	args := m.Called(ids)
 	if args.Get(0) == nil {
@@ -79,6 +185,36 @@ func (m *StubLocalProductRepo) FindMany(ids []uuid.UUID) ([]domainmodel.LocalPro
 	return args.Get(0).([]domainmodel.LocalProduct), args.Error(1)
 }
 
+type RecordingLocalProductRepo struct {
+	StubLocalProductRepo
+	stored []domainmodel.LocalProduct
+}
+
+func (m *RecordingLocalProductRepo) Store(product domainmodel.LocalProduct) error {
+	m.stored = append(m.stored, product)
+	return nil
+}
+
+type StubProjectionFallback struct {
+	mock.Mock
+}
+
+func (m *StubProjectionFallback) FindUser(_ context.Context, userID uuid.UUID) (*domainmodel.LocalUser, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmodel.LocalUser), args.Error(1)
+}
+
+func (m *StubProjectionFallback) FindProducts(_ context.Context, productIDs []uuid.UUID) ([]domainmodel.LocalProduct, error) {
+	args := m.Called(productIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domainmodel.LocalProduct), args.Error(1)
+}
+
 type StubOrderRepo struct {
 	mock.Mock
 }
@@ -93,7 +229,18 @@ func (m *StubOrderRepo) Store(o domainmodel.Order) error {
 	return args.Error(0)
 }
 
-func (m *StubOrderRepo) Find(_ uuid.UUID) (*domainmodel.Order, error) { return nil, nil }
+func (m *StubOrderRepo) Find(id uuid.UUID) (*domainmodel.Order, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmodel.Order), args.Error(1)
+}
+
+func (m *StubOrderRepo) FindIDsByUser(userID uuid.UUID, statuses []domainmodel.OrderStatus) ([]uuid.UUID, error) {
+	args := m.Called(userID, statuses)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
 
 type MockTemporalClient struct {
 	mock.Mock
@@ -108,10 +255,42 @@ func (m *MockTemporalClient) ExecuteWorkflow(ctx context.Context, options client
 	return callArgs.Get(0).(client.WorkflowRun), callArgs.Error(1)
 }
 
+func (m *MockTemporalClient) SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error {
+	return m.Called(ctx, workflowID, runID, signalName, arg).Error(0)
+}
+
+func (m *MockTemporalClient) QueryWorkflowWithOptions(ctx context.Context, request *client.QueryWorkflowWithOptionsRequest) (*client.QueryWorkflowWithOptionsResponse, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*client.QueryWorkflowWithOptionsResponse), args.Error(1)
+}
+
+func (m *MockTemporalClient) DescribeWorkflowExecution(ctx context.Context, workflowID, runID string) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	args := m.Called(ctx, workflowID, runID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*workflowservice.DescribeWorkflowExecutionResponse), args.Error(1)
+}
+
+// StubProgressValue - ответ query-обработчика прогресса без сериализации
+type StubProgressValue struct {
+	progress workflows.OrderProgress
+}
+
+func (v StubProgressValue) HasValue() bool { return true }
+
+func (v StubProgressValue) Get(valuePtr interface{}) error {
+	*valuePtr.(*workflows.OrderProgress) = v.progress
+	return nil
+}
+
 func TestOrderAppService_CreateOrder(t *testing.T) {
 	provider := new(MockRepositoryProvider)
 	uow := &MockUnitOfWork{provider: provider}
-	luow := new(MockLockableUnitOfWork)
+	luow := &PassThroughLockableUnitOfWork{provider: provider}
 	temporalClient := new(MockTemporalClient)
 
 	userID := uuid.New()
@@ -126,6 +305,7 @@ func TestOrderAppService_CreateOrder(t *testing.T) {
 		provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
 		provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
 		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
 
 		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
 
@@ -136,10 +316,9 @@ func TestOrderAppService_CreateOrder(t *testing.T) {
 		orderRepo.On("NextID").Return(orderID, nil)
 		orderRepo.On("Store", mock.AnythingOfType("model.Order")).Return(nil)
 
-		temporalClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
-
 		dummyDispatcher := &DummyDispatcher{}
-		service := NewOrderService(uow, luow, dummyDispatcher, temporalClient)
+		workflowDispatcher := &RecordingDispatcher{}
+		service := NewOrderService(uow, luow, dummyDispatcher, workflowDispatcher, temporalClient, nil, nil, 0)
 
 		createOrderCmd := model.CreateOrder{
 			UserID: userID,
@@ -149,9 +328,462 @@ func TestOrderAppService_CreateOrder(t *testing.T) {
 		id, err := service.CreateOrder(context.Background(), createOrderCmd)
 		assert.NoError(t, err)
 		assert.Equal(t, orderID, id)
+		// заказ создается под локом пользователя даже без ключа идемпотентности
+		assert.Equal(t, [][]string{{userOrdersLock(userID)}}, luow.lockNames)
+
+		// workflow запускается через outbox, а не напрямую
+		temporalClient.AssertNotCalled(t, "ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		assert.Equal(t, []outbox.Event{&model.StartCreateOrderWorkflow{
+			OrderID:    orderID,
+			UserID:     userID,
+			Items:      createOrderCmd.Items,
+			TotalPrice: 100,
+		}}, workflowDispatcher.events)
+	})
+}
+
+func TestOrderAppService_CreateOrderMergesDuplicateItems(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	orderID := uuid.New()
+
+	userRepo := new(StubLocalUserRepo)
+	userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+	prodRepo := new(StubLocalProductRepo)
+	prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+		{ProductID: productID, Price: 100},
+	}, nil)
+	orderRepo := new(StubOrderRepo)
+	orderRepo.On("NextID").Return(orderID, nil)
+	orderRepo.On("Store", mock.MatchedBy(func(o domainmodel.Order) bool {
+		return len(o.Items) == 1 && o.Items[0].Quantity == 3 && o.TotalPrice == 300
+	})).Return(nil)
+
+	provider := new(MockRepositoryProvider)
+	provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+	provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+	service := NewOrderService(&MockUnitOfWork{provider: provider}, &PassThroughLockableUnitOfWork{provider: provider}, &DummyDispatcher{}, &DummyDispatcher{}, new(MockTemporalClient), nil, nil, 0)
+
+	_, err := service.CreateOrder(context.Background(), model.CreateOrder{
+		UserID: userID,
+		Items: []model.OrderItem{
+			{ProductID: productID, Quantity: 1},
+			{ProductID: productID, Quantity: 2},
+		},
+	})
+	assert.NoError(t, err)
+	orderRepo.AssertExpectations(t)
+}
+
+func TestOrderAppService_CreateOrderRejectsDeleted(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	deletedAt := time.Now()
+	cmd := model.CreateOrder{
+		UserID: userID,
+		Items:  []model.OrderItem{{ProductID: productID, Quantity: 1}},
+	}
+
+	newService := func(userRepo *StubLocalUserRepo, prodRepo *StubLocalProductRepo) OrderService {
+		provider := new(MockRepositoryProvider)
+		provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+		provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+		return NewOrderService(
+			&MockUnitOfWork{provider: provider},
+			&PassThroughLockableUnitOfWork{provider: provider},
+			&DummyDispatcher{},
+			&DummyDispatcher{},
+			new(MockTemporalClient),
+			nil,
+			nil,
+			0,
+		)
+	}
+
+	t.Run("deleted user", func(t *testing.T) {
+		userRepo := new(StubLocalUserRepo)
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID, DeletedAt: &deletedAt}, nil)
+
+		_, err := newService(userRepo, new(StubLocalProductRepo)).CreateOrder(context.Background(), cmd)
+		assert.ErrorIs(t, err, domainmodel.ErrUserDeleted)
+	})
+
+	t.Run("blocked user", func(t *testing.T) {
+		userRepo := new(StubLocalUserRepo)
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID, Status: domainmodel.LocalUserBlocked}, nil)
+
+		_, err := newService(userRepo, new(StubLocalProductRepo)).CreateOrder(context.Background(), cmd)
+		assert.ErrorIs(t, err, domainmodel.ErrUserBlocked)
+	})
+
+	t.Run("deleted product", func(t *testing.T) {
+		userRepo := new(StubLocalUserRepo)
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+		prodRepo := new(StubLocalProductRepo)
+		prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+			{ProductID: productID, Price: 100, DeletedAt: &deletedAt},
+		}, nil)
+
+		_, err := newService(userRepo, prodRepo).CreateOrder(context.Background(), cmd)
+		assert.ErrorIs(t, err, domainmodel.ErrProductDeleted)
+	})
+}
+
+func TestOrderAppService_CreateOrderProjectionFallback(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	orderID := uuid.New()
+	cmd := model.CreateOrder{
+		UserID: userID,
+		Items:  []model.OrderItem{{ProductID: productID, Quantity: 2}},
+	}
+
+	newService := func(prodRepo *RecordingLocalProductRepo, fallback *StubProjectionFallback) (OrderService, *PassThroughLockableUnitOfWork) {
+		userRepo := new(StubLocalUserRepo)
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+		orderRepo := new(StubOrderRepo)
+		orderRepo.On("NextID").Return(orderID, nil)
+		orderRepo.On("Store", mock.AnythingOfType("model.Order")).Return(nil)
+
+		provider := new(MockRepositoryProvider)
+		provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+		provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+		luow := &PassThroughLockableUnitOfWork{provider: provider}
+		return NewOrderService(
+			&MockUnitOfWork{provider: provider},
+			luow,
+			&DummyDispatcher{},
+			&DummyDispatcher{},
+			new(MockTemporalClient),
+			fallback,
+			nil,
+			0,
+		), luow
+	}
+
+	t.Run("missing product is fetched and stored", func(t *testing.T) {
+		prodRepo := new(RecordingLocalProductRepo)
+		prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{}, nil)
+		fallback := new(StubProjectionFallback)
+		service, luow := newService(prodRepo, fallback)
+		fallback.On("FindProducts", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+			{ProductID: productID, Price: 150, Version: 2},
+		}, nil).Run(func(mock.Arguments) {
+			// запрос к productservice не должен держать локи и транзакцию заказа
+			assert.False(t, luow.inTransaction)
+		})
+
+		id, err := service.CreateOrder(context.Background(), cmd)
+		assert.NoError(t, err)
+		assert.Equal(t, orderID, id)
+		assert.Len(t, prodRepo.stored, 1)
+	})
+
+	t.Run("upstream unavailable", func(t *testing.T) {
+		prodRepo := new(RecordingLocalProductRepo)
+		prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{}, nil)
+		fallback := new(StubProjectionFallback)
+		fallback.On("FindProducts", []uuid.UUID{productID}).Return(nil, errors.New("circuit breaker is open"))
+
+		service, _ := newService(prodRepo, fallback)
+		_, err := service.CreateOrder(context.Background(), cmd)
+		assert.ErrorIs(t, err, domainmodel.ErrProductNotFound)
+		assert.Empty(t, prodRepo.stored)
+	})
+}
+
+func TestOrderAppService_CreateOrderWithIdempotencyKey(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	existingOrderID := uuid.New()
+	ctx := context.Background()
+
+	cmd := model.CreateOrder{
+		UserID:         userID,
+		Items:          []model.OrderItem{{ProductID: productID, Quantity: 2}},
+		IdempotencyKey: "retry-1",
+	}
+
+	newService := func(keyRepo *StubIdempotencyKeyRepo) OrderService {
+		provider := new(MockRepositoryProvider)
+		provider.On("IdempotencyKeyRepository", mock.Anything).Return(keyRepo)
+		return NewOrderService(
+			&MockUnitOfWork{provider: provider},
+			&PassThroughLockableUnitOfWork{provider: provider},
+			&DummyDispatcher{},
+			&DummyDispatcher{},
+			new(MockTemporalClient),
+			nil,
+			nil,
+			0,
+		)
+	}
+
+	t.Run("returns original order on retry", func(t *testing.T) {
+		keyRepo := new(StubIdempotencyKeyRepo)
+		keyRepo.On("Find", userID, "retry-1").Return(&domainmodel.IdempotencyKey{
+			UserID:      userID,
+			Key:         "retry-1",
+			RequestHash: createOrderRequestHash(cmd),
+			OrderID:     existingOrderID,
+		}, nil)
+
+		id, err := newService(keyRepo).CreateOrder(ctx, cmd)
+		assert.NoError(t, err)
+		assert.Equal(t, existingOrderID, id)
+		keyRepo.AssertNotCalled(t, "Store", mock.Anything)
+	})
+
+	t.Run("conflict on different payload", func(t *testing.T) {
+		keyRepo := new(StubIdempotencyKeyRepo)
+		keyRepo.On("Find", userID, "retry-1").Return(&domainmodel.IdempotencyKey{
+			UserID:      userID,
+			Key:         "retry-1",
+			RequestHash: "other",
+			OrderID:     existingOrderID,
+		}, nil)
+
+		_, err := newService(keyRepo).CreateOrder(ctx, cmd)
+		assert.ErrorIs(t, err, domainmodel.ErrIdempotencyKeyConflict)
+	})
+
+	t.Run("hash ignores items order", func(t *testing.T) {
+		otherProductID := uuid.New()
+		a := model.CreateOrder{Items: []model.OrderItem{{ProductID: productID, Quantity: 1}, {ProductID: otherProductID, Quantity: 2}}}
+		b := model.CreateOrder{Items: []model.OrderItem{{ProductID: otherProductID, Quantity: 2}, {ProductID: productID, Quantity: 1}}}
+		assert.Equal(t, createOrderRequestHash(a), createOrderRequestHash(b))
+	})
+}
+
+func TestOrderAppService_RequestCancellation(t *testing.T) {
+	userID := uuid.New()
+	orderID := uuid.New()
+	ctx := context.Background()
+
+	newService := func(order *domainmodel.Order) (OrderService, *MockTemporalClient) {
+		provider := new(MockRepositoryProvider)
+		orderRepo := new(StubOrderRepo)
+		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+		orderRepo.On("Find", orderID).Return(order, nil)
+
+		temporalClient := new(MockTemporalClient)
+		return NewOrderService(&MockUnitOfWork{provider: provider}, new(MockLockableUnitOfWork), &DummyDispatcher{}, &DummyDispatcher{}, temporalClient, nil, nil, 0), temporalClient
+	}
+
+	t.Run("signals running workflow", func(t *testing.T) {
+		service, temporalClient := newService(&domainmodel.Order{OrderID: orderID, UserID: userID, Status: domainmodel.StatusPaymentPending})
+		temporalClient.On("SignalWorkflow", ctx, "order_"+orderID.String(), "", workflows.CancelOrderSignal, workflows.CancelOrderRequest{Reason: "changed mind", Actor: domainmodel.ActorCustomer}).Return(nil)
+
+		err := service.RequestCancellation(ctx, orderID, userID, "changed mind", domainmodel.ActorCustomer)
+		assert.NoError(t, err)
+		temporalClient.AssertNotCalled(t, "ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("starts compensation when workflow finished", func(t *testing.T) {
+		service, temporalClient := newService(&domainmodel.Order{OrderID: orderID, UserID: userID, TotalPrice: 300, Status: domainmodel.StatusPaid})
+		temporalClient.On("SignalWorkflow", ctx, mock.Anything, "", workflows.CancelOrderSignal, mock.Anything).
+			Return(serviceerror.NewNotFound("workflow execution already completed"))
+		temporalClient.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(o client.StartWorkflowOptions) bool {
+			return o.ID == "order_cancel_"+orderID.String()
+		}), mock.Anything, []interface{}{workflows.CancelOrderParams{
+			OrderID:    orderID.String(),
+			UserID:     userID.String(),
+			TotalPrice: 300,
+			Reason:     "changed mind",
+			Actor:      domainmodel.ActorCustomer,
+		}}).Return(nil, nil).Once()
+
+		err := service.RequestCancellation(ctx, orderID, userID, "changed mind", domainmodel.ActorCustomer)
+		assert.NoError(t, err)
+		temporalClient.AssertExpectations(t)
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		service, temporalClient := newService(&domainmodel.Order{OrderID: orderID, UserID: userID, Status: domainmodel.StatusCancelled})
+
+		err := service.RequestCancellation(ctx, orderID, userID, "changed mind", domainmodel.ActorCustomer)
+		assert.NoError(t, err)
+		temporalClient.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("shipped order", func(t *testing.T) {
+		service, temporalClient := newService(&domainmodel.Order{OrderID: orderID, UserID: userID, Status: domainmodel.StatusShipped})
+
+		err := service.RequestCancellation(ctx, orderID, userID, "changed mind", domainmodel.ActorCustomer)
+		assert.ErrorIs(t, err, domainmodel.ErrInvalidStatus)
+		temporalClient.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("another customer's order", func(t *testing.T) {
+		service, temporalClient := newService(&domainmodel.Order{OrderID: orderID, UserID: userID, Status: domainmodel.StatusPaymentPending})
+
+		err := service.RequestCancellation(ctx, orderID, uuid.New(), "changed mind", domainmodel.ActorCustomer)
+		assert.ErrorIs(t, err, domainmodel.ErrOrderNotFound)
+		temporalClient.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("admin cancels any order", func(t *testing.T) {
+		service, temporalClient := newService(&domainmodel.Order{OrderID: orderID, UserID: userID, Status: domainmodel.StatusPaymentPending})
+		temporalClient.On("SignalWorkflow", ctx, "order_"+orderID.String(), "", workflows.CancelOrderSignal, workflows.CancelOrderRequest{Reason: "fraud", Actor: domainmodel.ActorAdmin}).Return(nil).Once()
+
+		err := service.RequestCancellation(ctx, orderID, uuid.Nil, "fraud", domainmodel.ActorAdmin)
+		assert.NoError(t, err)
+		temporalClient.AssertExpectations(t)
+	})
+}
+
+func TestOrderAppService_Approval(t *testing.T) {
+	orderID := uuid.New()
+	ctx := context.Background()
+
+	newService := func(status domainmodel.OrderStatus) (OrderService, *MockTemporalClient) {
+		provider := new(MockRepositoryProvider)
+		orderRepo := new(StubOrderRepo)
+		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		orderRepo.On("Find", orderID).Return(&domainmodel.Order{OrderID: orderID, Status: status}, nil)
+
+		temporalClient := new(MockTemporalClient)
+		return NewOrderService(&MockUnitOfWork{provider: provider}, new(MockLockableUnitOfWork), &DummyDispatcher{}, &DummyDispatcher{}, temporalClient, nil, nil, 0), temporalClient
+	}
+
+	t.Run("approve signals workflow", func(t *testing.T) {
+		service, temporalClient := newService(domainmodel.StatusAwaitingApproval)
+		temporalClient.On("SignalWorkflow", ctx, "order_"+orderID.String(), "", workflows.ApproveOrderSignal, nil).Return(nil).Once()
+
+		err := service.ApproveOrder(ctx, orderID)
+		assert.NoError(t, err)
+		temporalClient.AssertExpectations(t)
+	})
+
+	t.Run("reject passes reason and actor", func(t *testing.T) {
+		service, temporalClient := newService(domainmodel.StatusAwaitingApproval)
+		temporalClient.On("SignalWorkflow", ctx, "order_"+orderID.String(), "", workflows.RejectOrderSignal, workflows.RejectOrderRequest{
+			Reason: "fraud",
+			Actor:  domainmodel.ActorAdmin,
+		}).Return(nil).Once()
+
+		err := service.RejectOrder(ctx, orderID, "fraud", domainmodel.ActorAdmin)
+		assert.NoError(t, err)
+		temporalClient.AssertExpectations(t)
+	})
+
+	t.Run("order is not awaiting approval", func(t *testing.T) {
+		service, temporalClient := newService(domainmodel.StatusPaymentPending)
+
+		err := service.ApproveOrder(ctx, orderID)
+		assert.ErrorIs(t, err, domainmodel.ErrInvalidStatus)
+		err = service.RejectOrder(ctx, orderID, "fraud", domainmodel.ActorAdmin)
+		assert.ErrorIs(t, err, domainmodel.ErrInvalidStatus)
+		temporalClient.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOrderAppService_MarkDelivered(t *testing.T) {
+	orderID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	orderRepo := new(StubOrderRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+	orderRepo.On("Find", orderID).Return(&domainmodel.Order{OrderID: orderID, Status: domainmodel.StatusShipped}, nil)
+	orderRepo.On("Store", mock.MatchedBy(func(o domainmodel.Order) bool {
+		return o.Status == domainmodel.StatusDelivered
+	})).Return(nil).Once()
+
+	workflowDispatcher := &RecordingDispatcher{}
+	service := NewOrderService(
+		&MockUnitOfWork{provider: provider},
+		&PassThroughLockableUnitOfWork{provider: provider},
+		&DummyDispatcher{},
+		workflowDispatcher,
+		new(MockTemporalClient),
+		nil,
+		nil,
+		0,
+	)
+
+	err := service.MarkDelivered(context.Background(), orderID)
+	assert.NoError(t, err)
+	orderRepo.AssertExpectations(t)
+	// таймер автозавершения заводится в той же транзакции, что и доставка
+	assert.Equal(t, []outbox.Event{&model.StartCompleteOrderWorkflow{OrderID: orderID}}, workflowDispatcher.events)
+}
+
+func TestOrderAppService_GetOrderProgress(t *testing.T) {
+	orderID := uuid.New()
+	ctx := context.Background()
+
+	provider := new(MockRepositoryProvider)
+	orderRepo := new(StubOrderRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+	orderRepo.On("Find", orderID).Return(&domainmodel.Order{OrderID: orderID, Status: domainmodel.StatusPaid}, nil)
+
+	t.Run("adds retries of running activity", func(t *testing.T) {
+		temporalClient := new(MockTemporalClient)
+		service := NewOrderService(&MockUnitOfWork{provider: provider}, new(MockLockableUnitOfWork), &DummyDispatcher{}, &DummyDispatcher{}, temporalClient, nil, nil, 0)
+		temporalClient.On("QueryWorkflowWithOptions", ctx, mock.Anything).Return(&client.QueryWorkflowWithOptionsResponse{
+			QueryResult: StubProgressValue{progress: workflows.OrderProgress{
+				Step:     workflows.StepCharging,
+				Activity: "CapturePayment",
+				Attempts: map[string]int{workflows.StepAuthorizing: 1, workflows.StepCharging: 2},
+			}},
+		}, nil)
+		temporalClient.On("DescribeWorkflowExecution", ctx, "order_"+orderID.String(), "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+			PendingActivities: []*workflowpb.PendingActivityInfo{{
+				ActivityType: &commonpb.ActivityType{Name: "CapturePayment"},
+				Attempt:      3,
+				LastFailure:  &failurepb.Failure{Message: "payment service unavailable"},
+			}},
+		}, nil)
+
+		progress, err := service.GetOrderProgress(ctx, orderID)
+		assert.NoError(t, err)
+		assert.True(t, progress.Running)
+		assert.Equal(t, workflows.StepCharging, progress.Step)
+		// SetOrderPaymentPending и три попытки CapturePayment
+		assert.Equal(t, map[string]int{workflows.StepAuthorizing: 1, workflows.StepCharging: 4}, progress.Attempts)
+		assert.Equal(t, "payment service unavailable", progress.LastError)
+	})
+
+	t.Run("falls back to order status when workflow closed", func(t *testing.T) {
+		temporalClient := new(MockTemporalClient)
+		service := NewOrderService(&MockUnitOfWork{provider: provider}, new(MockLockableUnitOfWork), &DummyDispatcher{}, &DummyDispatcher{}, temporalClient, nil, nil, 0)
+		temporalClient.On("QueryWorkflowWithOptions", ctx, mock.MatchedBy(func(r *client.QueryWorkflowWithOptionsRequest) bool {
+			return r.WorkflowID == "order_"+orderID.String() && r.QueryType == workflows.OrderProgressQuery
+		})).Return(&client.QueryWorkflowWithOptionsResponse{QueryRejected: &querypb.QueryRejected{}}, nil)
+
+		progress, err := service.GetOrderProgress(ctx, orderID)
+		assert.NoError(t, err)
+		assert.False(t, progress.Running)
+		assert.Equal(t, int(domainmodel.StatusPaid), progress.Status)
+	})
+
+	t.Run("falls back when workflow not found", func(t *testing.T) {
+		temporalClient := new(MockTemporalClient)
+		service := NewOrderService(&MockUnitOfWork{provider: provider}, new(MockLockableUnitOfWork), &DummyDispatcher{}, &DummyDispatcher{}, temporalClient, nil, nil, 0)
+		temporalClient.On("QueryWorkflowWithOptions", ctx, mock.Anything).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+		progress, err := service.GetOrderProgress(ctx, orderID)
+		assert.NoError(t, err)
+		assert.False(t, progress.Running)
 	})
 }
 
 type DummyDispatcher struct{}
 
 func (d *DummyDispatcher) Dispatch(_ context.Context, _ outbox.Event) error { return nil }
+
+type RecordingDispatcher struct {
+	events []outbox.Event
+}
+
+func (d *RecordingDispatcher) Dispatch(_ context.Context, event outbox.Event) error {
+	d.events = append(d.events, event)
+	return nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+// ProjectionFallback читает пользователя и товары напрямую у сервисов-владельцев,
+// когда события еще не дошли до local_user/local_product
+type ProjectionFallback interface {
+	// FindUser возвращает model.ErrUserNotFound, если пользователя нет и у владельца
+	FindUser(ctx context.Context, userID uuid.UUID) (*model.LocalUser, error)
+	// FindProducts возвращает только найденные товары
+	FindProducts(ctx context.Context, productIDs []uuid.UUID) ([]model.LocalProduct, error)
+}
+
+// upstreamData - пользователь и товары, дочитанные у владельцев до открытия транзакции. Пустые, если все есть в проекциях
+type upstreamData struct {
+	user     *model.LocalUser
+	products []model.LocalProduct
+}
+
+// fetchUpstream дочитывает у владельцев то, чего нет в проекциях. Запросы идут вне транзакции заказа,
+// чтобы она не держала локи и соединение, пока ждет ответа по сети. Сохраняются данные уже в ней
+func (s *orderService) fetchUpstream(ctx context.Context, userID uuid.UUID, productIDs []uuid.UUID) (upstreamData, error) {
+	var data upstreamData
+	if s.projectionFallback == nil {
+		return data, nil
+	}
+
+	var (
+		userMissing     bool
+		missingProducts []uuid.UUID
+	)
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		_, err := provider.LocalUserRepository(ctx).Find(userID)
+		if errors.Is(err, model.ErrUserNotFound) {
+			userMissing = true
+		} else if err != nil {
+			return err
+		}
+
+		products, err := provider.LocalProductRepository(ctx).FindMany(productIDs)
+		if err != nil {
+			return err
+		}
+		missingProducts = missingProductIDs(productIDs, products)
+		return nil
+	})
+	if err != nil {
+		return data, err
+	}
+
+	// владелец недоступен или тоже ничего не знает - ответим по проекции
+	if userMissing {
+		user, fetchErr := s.projectionFallback.FindUser(ctx, userID)
+		if fetchErr == nil {
+			data.user = user
+		}
+	}
+	if len(missingProducts) > 0 {
+		products, fetchErr := s.projectionFallback.FindProducts(ctx, missingProducts)
+		if fetchErr == nil {
+			data.products = products
+		}
+	}
+	return data, nil
+}
+
+func missingProductIDs(productIDs []uuid.UUID, products []model.LocalProduct) []uuid.UUID {
+	found := make(map[uuid.UUID]struct{}, len(products))
+	for _, product := range products {
+		found[product.ProductID] = struct{}{}
+	}
+	var missing []uuid.UUID
+	for _, productID := range productIDs {
+		if _, ok := found[productID]; !ok {
+			found[productID] = struct{}{}
+			missing = append(missing, productID)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/domain/service"
+)
+
+type PromoService interface {
+	CreatePromoCode(ctx context.Context, promo appmodel.PromoCode) error
+}
+
+func NewPromoService(luow LockableUnitOfWork) PromoService {
+	return &promoService{
+		luow: luow,
+	}
+}
+
+type promoService struct {
+	luow LockableUnitOfWork
+}
+
+func (s *promoService) CreatePromoCode(ctx context.Context, promo appmodel.PromoCode) error {
+	code := service.NormalizePromoCode(promo.Code)
+	return s.luow.Execute(ctx, []string{promoCodeLock(code)}, func(provider RepositoryProvider) error {
+		return service.NewPromoService(
+			provider.PromoCodeRepository(ctx),
+			provider.PromoRedemptionRepository(ctx),
+		).CreatePromoCode(model.PromoCode{
+			Code:          code,
+			Type:          model.DiscountType(promo.Type),
+			Value:         promo.Value,
+			MinOrderValue: promo.MinOrderValue,
+			UsageLimit:    promo.UsageLimit,
+			PerUserLimit:  promo.PerUserLimit,
+			ValidFrom:     promo.ValidFrom,
+			ValidTo:       promo.ValidTo,
+		})
+	})
+}
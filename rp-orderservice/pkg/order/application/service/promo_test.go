@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+
+	"orderservice/pkg/order/application/model"
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+type StubPromoCodeRepo struct {
+	mock.Mock
+}
+
+func (m *StubPromoCodeRepo) Store(promo domainmodel.PromoCode) error {
+	return m.Called(promo).Error(0)
+}
+
+func (m *StubPromoCodeRepo) Find(code string) (*domainmodel.PromoCode, error) {
+	args := m.Called(code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmodel.PromoCode), args.Error(1)
+}
+
+type StubPromoRedemptionRepo struct {
+	mock.Mock
+}
+
+func (m *StubPromoRedemptionRepo) Store(redemption domainmodel.PromoRedemption) error {
+	return m.Called(redemption).Error(0)
+}
+
+func (m *StubPromoRedemptionRepo) FindForOrder(orderID uuid.UUID) (*domainmodel.PromoRedemption, error) {
+	args := m.Called(orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmodel.PromoRedemption), args.Error(1)
+}
+
+func (m *StubPromoRedemptionRepo) CountActive(code string, userID *uuid.UUID) (int, error) {
+	args := m.Called(code, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func TestOrderAppService_CreateOrderWithPromoCode(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	orderID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	userRepo := new(StubLocalUserRepo)
+	prodRepo := new(StubLocalProductRepo)
+	orderRepo := new(StubOrderRepo)
+	promoRepo := new(StubPromoCodeRepo)
+	redemptionRepo := new(StubPromoRedemptionRepo)
+	provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+	provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+	provider.On("PromoCodeRepository", mock.Anything).Return(promoRepo)
+	provider.On("PromoRedemptionRepository", mock.Anything).Return(redemptionRepo)
+
+	userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+	prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+		{ProductID: productID, Price: 500},
+	}, nil)
+	promoRepo.On("Find", "SALE10").Return(&domainmodel.PromoCode{
+		Code:       "SALE10",
+		Type:       domainmodel.DiscountPercent,
+		Value:      10,
+		UsageLimit: 100,
+	}, nil)
+	redemptionRepo.On("CountActive", "SALE10", (*uuid.UUID)(nil)).Return(3, nil)
+	redemptionRepo.On("Store", mock.MatchedBy(func(r domainmodel.PromoRedemption) bool {
+		return r.Code == "SALE10" && r.OrderID == orderID && r.UserID == userID
+	})).Return(nil).Once()
+	orderRepo.On("NextID").Return(orderID, nil)
+	orderRepo.On("Store", mock.MatchedBy(func(o domainmodel.Order) bool {
+		return o.TotalPrice == 900 && o.Discount == 100 && o.PromoCode == "SALE10" && o.Items[0].Discount == 100
+	})).Return(nil).Once()
+
+	// код проверяется и расходуется под его локом
+	luow := new(MockLockableUnitOfWork)
+	luow.On("Execute", mock.Anything, []string{userOrdersLock(userID), promoCodeLock("SALE10")}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = args.Get(2).(func(provider RepositoryProvider) error)(provider)
+		}).
+		Return(nil)
+
+	workflowDispatcher := &RecordingDispatcher{}
+	service := NewOrderService(&MockUnitOfWork{provider: provider}, luow, &DummyDispatcher{}, workflowDispatcher, new(MockTemporalClient), nil, nil, 0)
+
+	cmd := model.CreateOrder{
+		UserID:    userID,
+		Items:     []model.OrderItem{{ProductID: productID, Quantity: 2}},
+		PromoCode: " sale10 ",
+	}
+	id, err := service.CreateOrder(context.Background(), cmd)
+	assert.NoError(t, err)
+	assert.Equal(t, orderID, id)
+	orderRepo.AssertExpectations(t)
+	redemptionRepo.AssertExpectations(t)
+	assert.Equal(t, []outbox.Event{&model.StartCreateOrderWorkflow{
+		OrderID:    orderID,
+		UserID:     userID,
+		Items:      cmd.Items,
+		TotalPrice: 900,
+	}}, workflowDispatcher.events)
+}
+
+func TestOrderAppService_CancelOrderReleasesPromoCode(t *testing.T) {
+	orderID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	orderRepo := new(StubOrderRepo)
+	redemptionRepo := new(StubPromoRedemptionRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+	provider.On("PromoCodeRepository", mock.Anything).Return(new(StubPromoCodeRepo))
+	provider.On("PromoRedemptionRepository", mock.Anything).Return(redemptionRepo)
+
+	order := &domainmodel.Order{OrderID: orderID, Status: domainmodel.StatusPaymentPending, PromoCode: "SALE10"}
+	orderRepo.On("Find", orderID).Return(order, nil)
+	orderRepo.On("Store", mock.Anything).Return(nil)
+	redemptionRepo.On("FindForOrder", orderID).Return(&domainmodel.PromoRedemption{Code: "SALE10", OrderID: orderID}, nil)
+	redemptionRepo.On("Store", mock.MatchedBy(func(r domainmodel.PromoRedemption) bool {
+		return r.ReleasedAt != nil
+	})).Return(nil).Once()
+
+	service := NewOrderService(
+		&MockUnitOfWork{provider: provider},
+		&PassThroughLockableUnitOfWork{provider: provider},
+		&DummyDispatcher{},
+		&DummyDispatcher{},
+		new(MockTemporalClient),
+		nil,
+		nil,
+		0,
+	)
+
+	err := service.CancelOrder(context.Background(), orderID, "Payment failed", domainmodel.ActorWorkflow)
+	assert.NoError(t, err)
+	redemptionRepo.AssertExpectations(t)
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/domain/service"
+)
+
+// QuoteSigner подписывает котировки и проверяет подпись токена. Срок действия проверяет сервис
+type QuoteSigner interface {
+	Sign(quote model.Quote) (string, error)
+	Verify(token string) (*model.Quote, error)
+}
+
+func (s *orderService) QuoteOrder(ctx context.Context, request appmodel.QuoteOrder) (appmodel.Quote, error) {
+	if s.quoteSigner == nil {
+		return appmodel.Quote{}, errors.New("quotes are not configured")
+	}
+
+	items := mergeOrderItems(request.Items)
+	if len(items) == 0 {
+		return appmodel.Quote{}, model.ErrEmptyOrder
+	}
+	promoCode := service.NormalizePromoCode(request.PromoCode)
+
+	upstream, err := s.fetchUpstream(ctx, request.UserID, orderProductIDs(items))
+	if err != nil {
+		return appmodel.Quote{}, err
+	}
+
+	var domainItems []model.OrderItem
+	err = s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		var err error
+		domainItems, err = s.priceItems(ctx, provider, request.UserID, items, promoCode, nil, upstream)
+		return err
+	})
+	if err != nil {
+		return appmodel.Quote{}, err
+	}
+
+	quote := model.Quote{
+		UserID:    request.UserID,
+		Items:     domainItems,
+		PromoCode: promoCode,
+		ExpiresAt: time.Now().Add(s.quoteTTL),
+	}
+	result := appmodel.Quote{
+		Items:     make([]appmodel.QuotedItem, len(domainItems)),
+		ExpiresAt: quote.ExpiresAt.Unix(),
+	}
+	for i, item := range domainItems {
+		quote.TotalPrice += item.Price*int64(item.Quantity) - item.Discount
+		result.Discount += item.Discount
+		result.Items[i] = appmodel.QuotedItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			Discount:  item.Discount,
+		}
+	}
+	result.TotalPrice = quote.TotalPrice
+
+	result.Token, err = s.quoteSigner.Sign(quote)
+	return result, err
+}
+
+// verifyQuote проверяет подпись токена и что он выдан на этот заказ.
+// Позиции и промокод в запросе можно не передавать - тогда они берутся из котировки
+func (s *orderService) verifyQuote(order appmodel.CreateOrder) (*model.Quote, error) {
+	if s.quoteSigner == nil {
+		return nil, errors.WithStack(model.ErrInvalidQuote)
+	}
+
+	quote, err := s.quoteSigner.Verify(order.QuoteToken)
+	if err != nil {
+		return nil, err
+	}
+	if quote.UserID != order.UserID {
+		return nil, errors.Wrap(model.ErrInvalidQuote, "quote was issued for another user")
+	}
+	if order.PromoCode != "" && order.PromoCode != quote.PromoCode {
+		return nil, errors.Wrap(model.ErrInvalidQuote, "promo code differs from quoted one")
+	}
+	if len(order.Items) == 0 {
+		return quote, nil
+	}
+
+	quoted := make(map[uuid.UUID]int, len(quote.Items))
+	for _, item := range quote.Items {
+		quoted[item.ProductID] = item.Quantity
+	}
+	if len(order.Items) != len(quoted) {
+		return nil, errors.Wrap(model.ErrInvalidQuote, "items differ from quoted ones")
+	}
+	for _, item := range order.Items {
+		if quoted[item.ProductID] != item.Quantity {
+			return nil, errors.Wrap(model.ErrInvalidQuote, "items differ from quoted ones")
+		}
+	}
+	return quote, nil
+}
+
+func quoteOrderItems(quote *model.Quote) []appmodel.OrderItem {
+	items := make([]appmodel.OrderItem, len(quote.Items))
+	for i, item := range quote.Items {
+		items[i] = appmodel.OrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	return items
+}
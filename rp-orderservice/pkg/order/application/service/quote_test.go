@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"orderservice/pkg/order/application/model"
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+// StubQuoteSigner хранит выданные котировки в памяти вместо подписи
+type StubQuoteSigner struct {
+	quotes map[string]domainmodel.Quote
+}
+
+func (s *StubQuoteSigner) Sign(quote domainmodel.Quote) (string, error) {
+	if s.quotes == nil {
+		s.quotes = make(map[string]domainmodel.Quote)
+	}
+	token := uuid.NewString()
+	s.quotes[token] = quote
+	return token, nil
+}
+
+func (s *StubQuoteSigner) Verify(token string) (*domainmodel.Quote, error) {
+	quote, ok := s.quotes[token]
+	if !ok {
+		return nil, domainmodel.ErrInvalidQuote
+	}
+	return &quote, nil
+}
+
+func TestOrderAppService_CreateOrderByQuote(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	orderID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	userRepo := new(StubLocalUserRepo)
+	prodRepo := new(StubLocalProductRepo)
+	orderRepo := new(StubOrderRepo)
+	provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+	provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+
+	userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+	prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+		{ProductID: productID, Price: 500},
+	}, nil).Once()
+
+	signer := &StubQuoteSigner{}
+	service := NewOrderService(
+		&MockUnitOfWork{provider: provider},
+		&PassThroughLockableUnitOfWork{provider: provider},
+		&DummyDispatcher{},
+		&RecordingDispatcher{},
+		new(MockTemporalClient),
+		nil,
+		signer,
+		time.Minute,
+	)
+
+	quote, err := service.QuoteOrder(context.Background(), model.QuoteOrder{
+		UserID: userID,
+		Items:  []model.OrderItem{{ProductID: productID, Quantity: 2}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), quote.TotalPrice)
+	assert.Equal(t, []model.QuotedItem{{ProductID: productID, Quantity: 2, Price: 500}}, quote.Items)
+	assert.NotEmpty(t, quote.Token)
+
+	// цена выросла после котировки, заказ все равно оформляется по котировке
+	prodRepo.On("FindMany", []uuid.UUID{productID}).Return([]domainmodel.LocalProduct{
+		{ProductID: productID, Price: 700},
+	}, nil)
+	orderRepo.On("NextID").Return(orderID, nil)
+	orderRepo.On("Store", mock.MatchedBy(func(o domainmodel.Order) bool {
+		return o.TotalPrice == 1000 && o.Items[0].Price == 500 && o.Items[0].Quantity == 2
+	})).Return(nil).Once()
+
+	id, err := service.CreateOrder(context.Background(), model.CreateOrder{
+		UserID:     userID,
+		QuoteToken: quote.Token,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, orderID, id)
+	orderRepo.AssertExpectations(t)
+}
+
+func TestOrderAppService_CreateOrderByQuoteErrors(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+
+	newService := func(signer QuoteSigner) OrderService {
+		provider := new(MockRepositoryProvider)
+		return NewOrderService(
+			&MockUnitOfWork{provider: provider},
+			&PassThroughLockableUnitOfWork{provider: provider},
+			&DummyDispatcher{},
+			&DummyDispatcher{},
+			new(MockTemporalClient),
+			nil,
+			signer,
+			time.Minute,
+		)
+	}
+
+	t.Run("Expired quote", func(t *testing.T) {
+		signer := &StubQuoteSigner{}
+		token, _ := signer.Sign(domainmodel.Quote{
+			UserID:     userID,
+			Items:      []domainmodel.OrderItem{{ProductID: productID, Quantity: 1, Price: 500}},
+			TotalPrice: 500,
+			ExpiresAt:  time.Now().Add(-time.Second),
+		})
+
+		_, err := newService(signer).CreateOrder(context.Background(), model.CreateOrder{
+			UserID:     userID,
+			QuoteToken: token,
+		})
+		assert.ErrorIs(t, err, domainmodel.ErrQuoteExpired)
+	})
+
+	t.Run("Quote for other items", func(t *testing.T) {
+		signer := &StubQuoteSigner{}
+		token, _ := signer.Sign(domainmodel.Quote{
+			UserID:     userID,
+			Items:      []domainmodel.OrderItem{{ProductID: productID, Quantity: 1, Price: 500}},
+			TotalPrice: 500,
+			ExpiresAt:  time.Now().Add(time.Minute),
+		})
+
+		_, err := newService(signer).CreateOrder(context.Background(), model.CreateOrder{
+			UserID:     userID,
+			Items:      []model.OrderItem{{ProductID: productID, Quantity: 3}},
+			QuoteToken: token,
+		})
+		assert.ErrorIs(t, err, domainmodel.ErrInvalidQuote)
+	})
+
+	t.Run("Unknown token", func(t *testing.T) {
+		_, err := newService(&StubQuoteSigner{}).CreateOrder(context.Background(), model.CreateOrder{
+			UserID:     userID,
+			QuoteToken: "forged",
+		})
+		assert.ErrorIs(t, err, domainmodel.ErrInvalidQuote)
+	})
+}
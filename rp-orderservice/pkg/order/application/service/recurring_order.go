@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+	"github.com/google/uuid"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/domain/service"
+)
+
+type RecurringOrderService interface {
+	// CreateRecurringOrder сохраняет регулярный заказ и в той же транзакции заводит расписание
+	CreateRecurringOrder(ctx context.Context, recurringOrder appmodel.CreateRecurringOrder) (uuid.UUID, error)
+	PauseRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) error
+	ResumeRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) error
+	CancelRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) error
+	// PlaceOrder создает очередной заказ по расписанию через обычный CreateOrder
+	PlaceOrder(ctx context.Context, recurringOrderID, runID uuid.UUID) error
+}
+
+func NewRecurringOrderService(
+	uow UnitOfWork,
+	luow LockableUnitOfWork,
+	workflowDispatcher outbox.EventDispatcher[outbox.Event],
+	orderService OrderService,
+) RecurringOrderService {
+	return &recurringOrderService{
+		uow:                uow,
+		luow:               luow,
+		workflowDispatcher: workflowDispatcher,
+		orderService:       orderService,
+	}
+}
+
+type recurringOrderService struct {
+	uow                UnitOfWork
+	luow               LockableUnitOfWork
+	workflowDispatcher outbox.EventDispatcher[outbox.Event]
+	orderService       OrderService
+}
+
+func (s *recurringOrderService) CreateRecurringOrder(ctx context.Context, recurringOrder appmodel.CreateRecurringOrder) (uuid.UUID, error) {
+	items := mergeOrderItems(recurringOrder.Items)
+	domainItems := make([]model.OrderItem, len(items))
+	for i, item := range items {
+		domainItems[i] = model.OrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	var recurringOrderID uuid.UUID
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		var err error
+		recurringOrderID, err = s.domainService(ctx, provider).Create(recurringOrder.UserID, domainItems, recurringOrder.Interval)
+		if err != nil {
+			return err
+		}
+		return s.syncSchedule(ctx, provider, recurringOrderID)
+	})
+	return recurringOrderID, err
+}
+
+func (s *recurringOrderService) PauseRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) error {
+	return s.luow.Execute(ctx, []string{recurringOrderLock(recurringOrderID)}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).Pause(recurringOrderID)
+		if err != nil {
+			return err
+		}
+		return s.syncSchedule(ctx, provider, recurringOrderID)
+	})
+}
+
+func (s *recurringOrderService) ResumeRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) error {
+	return s.luow.Execute(ctx, []string{recurringOrderLock(recurringOrderID)}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).Resume(recurringOrderID)
+		if err != nil {
+			return err
+		}
+		return s.syncSchedule(ctx, provider, recurringOrderID)
+	})
+}
+
+func (s *recurringOrderService) CancelRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) error {
+	return s.luow.Execute(ctx, []string{recurringOrderLock(recurringOrderID)}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).Cancel(recurringOrderID)
+		if err != nil {
+			return err
+		}
+		return s.syncSchedule(ctx, provider, recurringOrderID)
+	})
+}
+
+// PlaceOrder не держит лок регулярного заказа, пока создается заказ: CreateOrder берет свои локи.
+// Ключ идемпотентности из запуска не дает повтору activity создать второй заказ.
+// Ошибка создания записывается в запуск и не возвращается, чтобы расписание не повторяло его бесконечно
+func (s *recurringOrderService) PlaceOrder(ctx context.Context, recurringOrderID, runID uuid.UUID) error {
+	var recurringOrder *model.RecurringOrder
+	err := s.luow.Execute(ctx, []string{recurringOrderLock(recurringOrderID)}, func(provider RepositoryProvider) error {
+		var err error
+		recurringOrder, err = provider.RecurringOrderRepository(ctx).Find(recurringOrderID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// пауза или отмена могли опередить расписание
+	if recurringOrder.Status != model.RecurringActive {
+		return nil
+	}
+
+	items := make([]appmodel.OrderItem, len(recurringOrder.Items))
+	for i, item := range recurringOrder.Items {
+		items[i] = appmodel.OrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	var failure string
+	orderID, err := s.orderService.CreateOrder(ctx, appmodel.CreateOrder{
+		UserID:            recurringOrder.UserID,
+		Items:             items,
+		IdempotencyKey:    recurringRunIdempotencyKey(runID),
+		SkipPaymentWindow: true,
+	})
+	var placedOrderID *uuid.UUID
+	if err != nil {
+		failure = err.Error()
+	} else {
+		placedOrderID = &orderID
+	}
+
+	return s.luow.Execute(ctx, []string{recurringOrderLock(recurringOrderID)}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).RecordRun(recurringOrderID, runID, placedOrderID, failure)
+	})
+}
+
+// syncSchedule пишет в outbox команду привести расписание к сохраненному состоянию
+func (s *recurringOrderService) syncSchedule(ctx context.Context, provider RepositoryProvider, recurringOrderID uuid.UUID) error {
+	recurringOrder, err := provider.RecurringOrderRepository(ctx).Find(recurringOrderID)
+	if err != nil {
+		return err
+	}
+
+	return s.workflowDispatcher.Dispatch(ctx, &appmodel.SyncRecurringOrderSchedule{
+		RecurringOrderID: recurringOrder.RecurringOrderID,
+		Interval:         recurringOrder.Interval,
+		Paused:           recurringOrder.Status == model.RecurringPaused,
+		Cancelled:        recurringOrder.Status == model.RecurringCancelled,
+	})
+}
+
+func (s *recurringOrderService) domainService(ctx context.Context, provider RepositoryProvider) service.RecurringOrderService {
+	return service.NewRecurringOrderService(provider.RecurringOrderRepository(ctx))
+}
+
+func recurringRunIdempotencyKey(runID uuid.UUID) string {
+	return "recurring_run_" + runID.String()
+}
+
+const baseRecurringOrderLock = "recurring_order_"
+
+func recurringOrderLock(id uuid.UUID) string {
+	return fmt.Sprintf("%s%s", baseRecurringOrderLock, id.String())
+}
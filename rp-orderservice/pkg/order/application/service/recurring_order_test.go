@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+
+	"orderservice/pkg/order/application/model"
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+type EmptyRecurringOrderRepo struct{}
+
+func (m *EmptyRecurringOrderRepo) NextID() (uuid.UUID, error)                   { return uuid.New(), nil }
+func (m *EmptyRecurringOrderRepo) Store(domainmodel.RecurringOrder) error       { return nil }
+func (m *EmptyRecurringOrderRepo) StoreRun(domainmodel.RecurringOrderRun) error { return nil }
+
+func (m *EmptyRecurringOrderRepo) Find(uuid.UUID) (*domainmodel.RecurringOrder, error) {
+	return nil, domainmodel.ErrRecurringOrderNotFound
+}
+
+func (m *EmptyRecurringOrderRepo) FindRunForOrder(uuid.UUID) (*domainmodel.RecurringOrderRun, error) {
+	return nil, domainmodel.ErrRecurringRunNotFound
+}
+
+type StubRecurringOrderRepo struct {
+	mock.Mock
+}
+
+func (m *StubRecurringOrderRepo) NextID() (uuid.UUID, error) {
+	args := m.Called()
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *StubRecurringOrderRepo) Store(recurringOrder domainmodel.RecurringOrder) error {
+	return m.Called(recurringOrder).Error(0)
+}
+
+func (m *StubRecurringOrderRepo) Find(recurringOrderID uuid.UUID) (*domainmodel.RecurringOrder, error) {
+	args := m.Called(recurringOrderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	recurringOrder := *args.Get(0).(*domainmodel.RecurringOrder)
+	return &recurringOrder, args.Error(1)
+}
+
+func (m *StubRecurringOrderRepo) StoreRun(run domainmodel.RecurringOrderRun) error {
+	return m.Called(run).Error(0)
+}
+
+func (m *StubRecurringOrderRepo) FindRunForOrder(orderID uuid.UUID) (*domainmodel.RecurringOrderRun, error) {
+	args := m.Called(orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	run := *args.Get(0).(*domainmodel.RecurringOrderRun)
+	return &run, args.Error(1)
+}
+
+// StubOrderService подменяет только CreateOrder, остальные методы регулярным заказам не нужны
+type StubOrderService struct {
+	OrderService
+	mock.Mock
+}
+
+func (m *StubOrderService) CreateOrder(_ context.Context, order model.CreateOrder) (uuid.UUID, error) {
+	args := m.Called(order)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func TestRecurringOrderAppService_PlaceOrder(t *testing.T) {
+	recurringOrderID := uuid.New()
+	runID := uuid.New()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	newService := func(status domainmodel.RecurringOrderStatus) (RecurringOrderService, *StubRecurringOrderRepo, *StubOrderService) {
+		recurringRepo := new(StubRecurringOrderRepo)
+		recurringRepo.On("Find", recurringOrderID).Return(&domainmodel.RecurringOrder{
+			RecurringOrderID: recurringOrderID,
+			UserID:           userID,
+			Items:            []domainmodel.OrderItem{{ProductID: productID, Quantity: 3}},
+			Interval:         7 * 24 * time.Hour,
+			Status:           status,
+		}, nil)
+		provider := &MockRepositoryProvider{recurringOrderRepo: recurringRepo}
+		orderService := new(StubOrderService)
+		service := NewRecurringOrderService(
+			&MockUnitOfWork{provider: provider},
+			&PassThroughLockableUnitOfWork{provider: provider},
+			&DummyDispatcher{},
+			orderService,
+		)
+		return service, recurringRepo, orderService
+	}
+
+	expectedOrder := model.CreateOrder{
+		UserID:            userID,
+		Items:             []model.OrderItem{{ProductID: productID, Quantity: 3}},
+		IdempotencyKey:    recurringRunIdempotencyKey(runID),
+		SkipPaymentWindow: true,
+	}
+
+	t.Run("Order is placed through CreateOrder", func(t *testing.T) {
+		service, recurringRepo, orderService := newService(domainmodel.RecurringActive)
+		orderID := uuid.New()
+		orderService.On("CreateOrder", expectedOrder).Return(orderID, nil).Once()
+		recurringRepo.On("StoreRun", mock.MatchedBy(func(run domainmodel.RecurringOrderRun) bool {
+			return run.RunID == runID && run.OrderID != nil && *run.OrderID == orderID && run.Status == domainmodel.RunPlaced
+		})).Return(nil).Once()
+
+		err := service.PlaceOrder(context.Background(), recurringOrderID, runID)
+		assert.NoError(t, err)
+		orderService.AssertExpectations(t)
+		recurringRepo.AssertExpectations(t)
+	})
+
+	t.Run("Failure is recorded, not returned", func(t *testing.T) {
+		service, recurringRepo, orderService := newService(domainmodel.RecurringActive)
+		orderService.On("CreateOrder", expectedOrder).Return(uuid.Nil, domainmodel.ErrProductDeleted).Once()
+		recurringRepo.On("StoreRun", mock.MatchedBy(func(run domainmodel.RecurringOrderRun) bool {
+			return run.OrderID == nil && run.Status == domainmodel.RunFailed && run.Error == domainmodel.ErrProductDeleted.Error()
+		})).Return(nil).Once()
+
+		err := service.PlaceOrder(context.Background(), recurringOrderID, runID)
+		assert.NoError(t, err)
+		recurringRepo.AssertExpectations(t)
+	})
+
+	t.Run("Paused order is not placed", func(t *testing.T) {
+		service, recurringRepo, orderService := newService(domainmodel.RecurringPaused)
+
+		err := service.PlaceOrder(context.Background(), recurringOrderID, runID)
+		assert.NoError(t, err)
+		orderService.AssertNotCalled(t, "CreateOrder", mock.Anything)
+		recurringRepo.AssertNotCalled(t, "StoreRun", mock.Anything)
+	})
+}
+
+func TestRecurringOrderAppService_PauseSyncsSchedule(t *testing.T) {
+	recurringOrderID := uuid.New()
+
+	recurringRepo := new(StubRecurringOrderRepo)
+	recurringRepo.On("Find", recurringOrderID).Return(&domainmodel.RecurringOrder{
+		RecurringOrderID: recurringOrderID,
+		Interval:         24 * time.Hour,
+		Status:           domainmodel.RecurringActive,
+	}, nil).Once()
+	recurringRepo.On("Store", mock.MatchedBy(func(r domainmodel.RecurringOrder) bool {
+		return r.Status == domainmodel.RecurringPaused
+	})).Return(nil).Once()
+	recurringRepo.On("Find", recurringOrderID).Return(&domainmodel.RecurringOrder{
+		RecurringOrderID: recurringOrderID,
+		Interval:         24 * time.Hour,
+		Status:           domainmodel.RecurringPaused,
+	}, nil)
+
+	provider := &MockRepositoryProvider{recurringOrderRepo: recurringRepo}
+	workflowDispatcher := &RecordingDispatcher{}
+	service := NewRecurringOrderService(
+		&MockUnitOfWork{provider: provider},
+		&PassThroughLockableUnitOfWork{provider: provider},
+		workflowDispatcher,
+		new(StubOrderService),
+	)
+
+	err := service.PauseRecurringOrder(context.Background(), recurringOrderID)
+	assert.NoError(t, err)
+	recurringRepo.AssertExpectations(t)
+	assert.Equal(t, []outbox.Event{&model.SyncRecurringOrderSchedule{
+		RecurringOrderID: recurringOrderID,
+		Interval:         24 * time.Hour,
+		Paused:           true,
+	}}, workflowDispatcher.events)
+}
+
+func TestOrderAppService_CancelOrderSkipsRecurringRun(t *testing.T) {
+	orderID := uuid.New()
+	runID := uuid.New()
+
+	recurringRepo := new(StubRecurringOrderRepo)
+	provider := &MockRepositoryProvider{recurringOrderRepo: recurringRepo}
+	orderRepo := new(StubOrderRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+
+	orderRepo.On("Find", orderID).Return(&domainmodel.Order{OrderID: orderID, Status: domainmodel.StatusPaymentPending}, nil)
+	orderRepo.On("Store", mock.Anything).Return(nil)
+	recurringRepo.On("FindRunForOrder", orderID).Return(&domainmodel.RecurringOrderRun{
+		RunID:   runID,
+		OrderID: &orderID,
+		Status:  domainmodel.RunPlaced,
+	}, nil)
+	recurringRepo.On("StoreRun", mock.MatchedBy(func(run domainmodel.RecurringOrderRun) bool {
+		return run.RunID == runID && run.Status == domainmodel.RunSkipped && run.Error == domainmodel.CancelReasonInsufficientFunds
+	})).Return(nil).Once()
+
+	service := NewOrderService(
+		&MockUnitOfWork{provider: provider},
+		&PassThroughLockableUnitOfWork{provider: provider},
+		&DummyDispatcher{},
+		&DummyDispatcher{},
+		new(MockTemporalClient),
+		nil,
+		nil,
+		0,
+	)
+
+	err := service.CancelOrder(context.Background(), orderID, domainmodel.CancelReasonInsufficientFunds, domainmodel.ActorWorkflow)
+	assert.NoError(t, err)
+	recurringRepo.AssertExpectations(t)
+}
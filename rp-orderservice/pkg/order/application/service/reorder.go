@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/domain/model"
+)
+
+// Reorder сверяет позиции заказа с текущими local_product и выдает котировку на то, что можно заказать снова.
+// Подтверждение создает заказ по этой котировке, поэтому клиент платит ровно показанную сумму.
+// Промокод исходного заказа не переносится
+func (s *orderService) Reorder(ctx context.Context, request appmodel.Reorder) (appmodel.ReorderResult, error) {
+	if request.Confirm && request.QuoteToken == "" {
+		return appmodel.ReorderResult{}, errors.Wrap(model.ErrInvalidQuote, "reorder is confirmed with the quote token from preview")
+	}
+
+	var order *model.Order
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		var err error
+		order, err = provider.OrderRepository(ctx).Find(request.OrderID)
+		return err
+	})
+	if err != nil {
+		return appmodel.ReorderResult{}, err
+	}
+	userID := order.UserID
+
+	var result appmodel.ReorderResult
+	if request.Confirm {
+		// позиции и цены берутся из котировки предпросмотра, CreateOrder проверит, что она выдана этому пользователю
+		result.OrderID, err = s.CreateOrder(ctx, appmodel.CreateOrder{
+			UserID:         userID,
+			QuoteToken:     request.QuoteToken,
+			IdempotencyKey: request.IdempotencyKey,
+		})
+		return result, err
+	}
+
+	productIDs := make([]uuid.UUID, len(order.Items))
+	for i, item := range order.Items {
+		productIDs[i] = item.ProductID
+	}
+	upstream, err := s.fetchUpstream(ctx, userID, productIDs)
+	if err != nil {
+		return result, err
+	}
+	err = s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		products, err := findProducts(provider.LocalProductRepository(ctx), productIDs, upstream)
+		if err != nil {
+			return err
+		}
+		result = reorderItems(order.Items, products)
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// заказывать нечего - и котировать тоже
+	if len(result.Items) == 0 {
+		return result, nil
+	}
+	items := make([]appmodel.OrderItem, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = appmodel.OrderItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+	quote, err := s.QuoteOrder(ctx, appmodel.QuoteOrder{
+		UserID: userID,
+		Items:  items,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// цена могла смениться между чтениями, показываем ту, что зафиксирована в котировке
+	quotedPrices := make(map[uuid.UUID]int64, len(quote.Items))
+	for _, item := range quote.Items {
+		quotedPrices[item.ProductID] = item.Price
+	}
+	for i := range result.Items {
+		result.Items[i].Price = quotedPrices[result.Items[i].ProductID]
+	}
+	result.TotalPrice = quote.TotalPrice
+	result.QuoteToken = quote.Token
+	result.ExpiresAt = quote.ExpiresAt
+	return result, nil
+}
+
+func reorderItems(items []model.OrderItem, products []model.LocalProduct) appmodel.ReorderResult {
+	productMap := make(map[uuid.UUID]model.LocalProduct, len(products))
+	for _, product := range products {
+		productMap[product.ProductID] = product
+	}
+
+	var result appmodel.ReorderResult
+	for _, item := range items {
+		product, ok := productMap[item.ProductID]
+		if !ok || product.DeletedAt != nil {
+			reason := appmodel.DropReasonProductUnavailable
+			if ok {
+				reason = appmodel.DropReasonProductDeleted
+			}
+			result.Dropped = append(result.Dropped, appmodel.DroppedItem{
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				Reason:    reason,
+			})
+			continue
+		}
+
+		result.Items = append(result.Items, appmodel.ReorderedItem{
+			ProductID:     item.ProductID,
+			Quantity:      item.Quantity,
+			PreviousPrice: item.Price,
+			Price:         product.Price,
+		})
+		result.TotalPrice += product.Price * int64(item.Quantity)
+	}
+	return result
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"orderservice/pkg/order/application/model"
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+func TestOrderAppService_Reorder(t *testing.T) {
+	userID := uuid.New()
+	oldOrderID := uuid.New()
+	newOrderID := uuid.New()
+	samePrice := uuid.New()
+	changedPrice := uuid.New()
+	deleted := uuid.New()
+	unavailable := uuid.New()
+	deletedAt := time.Now()
+
+	newService := func() (OrderService, *StubOrderRepo, *RecordingDispatcher) {
+		provider := new(MockRepositoryProvider)
+		userRepo := new(StubLocalUserRepo)
+		prodRepo := new(StubLocalProductRepo)
+		orderRepo := new(StubOrderRepo)
+		provider.On("LocalUserRepository", mock.Anything).Return(userRepo)
+		provider.On("LocalProductRepository", mock.Anything).Return(prodRepo)
+		provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+		provider.On("StatusTransitionRepository", mock.Anything).Return(&StubStatusTransitionRepo{})
+
+		userRepo.On("Find", userID).Return(&domainmodel.LocalUser{UserID: userID}, nil)
+		orderRepo.On("Find", oldOrderID).Return(&domainmodel.Order{
+			OrderID: oldOrderID,
+			UserID:  userID,
+			Items: []domainmodel.OrderItem{
+				{ProductID: samePrice, Quantity: 1, Price: 100},
+				{ProductID: changedPrice, Quantity: 2, Price: 200},
+				{ProductID: deleted, Quantity: 1, Price: 300},
+				{ProductID: unavailable, Quantity: 1, Price: 400},
+			},
+			Status: domainmodel.StatusCompleted,
+		}, nil)
+		prodRepo.On("FindMany", []uuid.UUID{samePrice, changedPrice, deleted, unavailable}).Return([]domainmodel.LocalProduct{
+			{ProductID: samePrice, Price: 100},
+			{ProductID: changedPrice, Price: 250},
+			{ProductID: deleted, Price: 300, DeletedAt: &deletedAt},
+		}, nil)
+		prodRepo.On("FindMany", []uuid.UUID{samePrice, changedPrice}).Return([]domainmodel.LocalProduct{
+			{ProductID: samePrice, Price: 100},
+			{ProductID: changedPrice, Price: 250},
+		}, nil)
+
+		workflowDispatcher := &RecordingDispatcher{}
+		service := NewOrderService(
+			&MockUnitOfWork{provider: provider},
+			&PassThroughLockableUnitOfWork{provider: provider},
+			&DummyDispatcher{},
+			workflowDispatcher,
+			new(MockTemporalClient),
+			nil,
+			&StubQuoteSigner{},
+			time.Minute,
+		)
+		return service, orderRepo, workflowDispatcher
+	}
+
+	expectedItems := []model.ReorderedItem{
+		{ProductID: samePrice, Quantity: 1, PreviousPrice: 100, Price: 100},
+		{ProductID: changedPrice, Quantity: 2, PreviousPrice: 200, Price: 250},
+	}
+	expectedDropped := []model.DroppedItem{
+		{ProductID: deleted, Quantity: 1, Reason: model.DropReasonProductDeleted},
+		{ProductID: unavailable, Quantity: 1, Reason: model.DropReasonProductUnavailable},
+	}
+
+	t.Run("preview does not create order", func(t *testing.T) {
+		service, orderRepo, workflowDispatcher := newService()
+
+		result, err := service.Reorder(context.Background(), model.Reorder{OrderID: oldOrderID})
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, result.OrderID)
+		assert.Equal(t, expectedItems, result.Items)
+		assert.Equal(t, expectedDropped, result.Dropped)
+		assert.Equal(t, int64(600), result.TotalPrice)
+		assert.NotEmpty(t, result.QuoteToken)
+		assert.False(t, result.Items[0].PriceChanged())
+		assert.True(t, result.Items[1].PriceChanged())
+		orderRepo.AssertNotCalled(t, "Store", mock.Anything)
+		assert.Empty(t, workflowDispatcher.events)
+	})
+
+	t.Run("confirm creates order at previewed prices", func(t *testing.T) {
+		service, orderRepo, workflowDispatcher := newService()
+		preview, err := service.Reorder(context.Background(), model.Reorder{OrderID: oldOrderID})
+		assert.NoError(t, err)
+
+		orderRepo.On("NextID").Return(newOrderID, nil)
+		orderRepo.On("Store", mock.MatchedBy(func(o domainmodel.Order) bool {
+			return o.OrderID == newOrderID && o.UserID == userID && len(o.Items) == 2 && o.TotalPrice == 600
+		})).Return(nil).Once()
+
+		result, err := service.Reorder(context.Background(), model.Reorder{OrderID: oldOrderID, Confirm: true, QuoteToken: preview.QuoteToken})
+		assert.NoError(t, err)
+		assert.Equal(t, newOrderID, result.OrderID)
+		orderRepo.AssertExpectations(t)
+		assert.Len(t, workflowDispatcher.events, 1)
+	})
+
+	t.Run("confirm without preview", func(t *testing.T) {
+		service, orderRepo, workflowDispatcher := newService()
+
+		_, err := service.Reorder(context.Background(), model.Reorder{OrderID: oldOrderID, Confirm: true})
+		assert.ErrorIs(t, err, domainmodel.ErrInvalidQuote)
+		orderRepo.AssertNotCalled(t, "Store", mock.Anything)
+		assert.Empty(t, workflowDispatcher.events)
+	})
+
+	t.Run("unknown order", func(t *testing.T) {
+		service, orderRepo, _ := newService()
+		missingID := uuid.New()
+		orderRepo.On("Find", missingID).Return(nil, domainmodel.ErrOrderNotFound)
+
+		_, err := service.Reorder(context.Background(), model.Reorder{OrderID: missingID})
+		assert.ErrorIs(t, err, domainmodel.ErrOrderNotFound)
+	})
+}
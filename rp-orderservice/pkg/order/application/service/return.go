@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+	"github.com/google/uuid"
+
+	"orderservice/pkg/common/domain"
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/domain/service"
+)
+
+type ReturnService interface {
+	RequestReturn(ctx context.Context, orderID uuid.UUID, items []appmodel.OrderItem, reason string) (uuid.UUID, error)
+	// ApproveReturn одобряет возврат и в той же транзакции запускает возврат товаров на склад и денег клиенту
+	ApproveReturn(ctx context.Context, returnID uuid.UUID) error
+	RejectReturn(ctx context.Context, returnID uuid.UUID, reason string) error
+	CompleteReturn(ctx context.Context, returnID uuid.UUID) error
+}
+
+func NewReturnService(
+	luow LockableUnitOfWork,
+	eventDispatcher outbox.EventDispatcher[outbox.Event],
+	workflowDispatcher outbox.EventDispatcher[outbox.Event],
+) ReturnService {
+	return &returnService{
+		luow:               luow,
+		eventDispatcher:    eventDispatcher,
+		workflowDispatcher: workflowDispatcher,
+	}
+}
+
+type returnService struct {
+	luow               LockableUnitOfWork
+	eventDispatcher    outbox.EventDispatcher[outbox.Event]
+	workflowDispatcher outbox.EventDispatcher[outbox.Event]
+}
+
+// RequestReturn блокирует заказ: параллельные заявки не должны вместе превысить купленное количество
+func (s *returnService) RequestReturn(ctx context.Context, orderID uuid.UUID, items []appmodel.OrderItem, reason string) (uuid.UUID, error) {
+	returnItems := make([]model.ReturnItem, len(items))
+	for i, item := range items {
+		returnItems[i] = model.ReturnItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	var returnID uuid.UUID
+	err := s.luow.Execute(ctx, []string{orderLock(orderID)}, func(provider RepositoryProvider) error {
+		var err error
+		returnID, err = s.domainService(ctx, provider).RequestReturn(orderID, returnItems, reason)
+		return err
+	})
+	return returnID, err
+}
+
+func (s *returnService) ApproveReturn(ctx context.Context, returnID uuid.UUID) error {
+	return s.luow.Execute(ctx, []string{returnLock(returnID)}, func(provider RepositoryProvider) error {
+		err := s.domainService(ctx, provider).Approve(returnID)
+		if err != nil {
+			return err
+		}
+
+		orderReturn, err := provider.ReturnRepository(ctx).Find(returnID)
+		if err != nil {
+			return err
+		}
+
+		items := make([]appmodel.OrderItem, len(orderReturn.Items))
+		for i, item := range orderReturn.Items {
+			items[i] = appmodel.OrderItem{
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+			}
+		}
+
+		return s.workflowDispatcher.Dispatch(ctx, &appmodel.StartReturnOrderWorkflow{
+			ReturnID:     orderReturn.ReturnID,
+			OrderID:      orderReturn.OrderID,
+			UserID:       orderReturn.UserID,
+			Items:        items,
+			RefundAmount: orderReturn.RefundAmount,
+		})
+	})
+}
+
+func (s *returnService) RejectReturn(ctx context.Context, returnID uuid.UUID, reason string) error {
+	return s.luow.Execute(ctx, []string{returnLock(returnID)}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).Reject(returnID, reason)
+	})
+}
+
+func (s *returnService) CompleteReturn(ctx context.Context, returnID uuid.UUID) error {
+	return s.luow.Execute(ctx, []string{returnLock(returnID)}, func(provider RepositoryProvider) error {
+		return s.domainService(ctx, provider).Complete(returnID)
+	})
+}
+
+func (s *returnService) domainService(ctx context.Context, provider RepositoryProvider) service.ReturnService {
+	return service.NewReturnService(
+		provider.OrderRepository(ctx),
+		provider.ReturnRepository(ctx),
+		s.domainEventDispatcher(ctx, provider),
+	)
+}
+
+func (s *returnService) domainEventDispatcher(ctx context.Context, provider RepositoryProvider) domain.EventDispatcher {
+	return &domainEventDispatcher{
+		ctx:             ctx,
+		eventDispatcher: s.eventDispatcher,
+		reportService: service.NewReportService(
+			provider.OrderRepository(ctx),
+			provider.ReportRepository(ctx),
+		),
+	}
+}
+
+const baseReturnLock = "order_return_"
+
+func returnLock(id uuid.UUID) string {
+	return fmt.Sprintf("%s%s", baseReturnLock, id.String())
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+
+	"orderservice/pkg/order/application/model"
+	domainmodel "orderservice/pkg/order/domain/model"
+)
+
+type StubReturnRepo struct {
+	mock.Mock
+}
+
+func (m *StubReturnRepo) NextID() (uuid.UUID, error) {
+	args := m.Called()
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *StubReturnRepo) Store(orderReturn domainmodel.Return) error {
+	return m.Called(orderReturn).Error(0)
+}
+
+func (m *StubReturnRepo) Find(returnID uuid.UUID) (*domainmodel.Return, error) {
+	args := m.Called(returnID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	orderReturn := *args.Get(0).(*domainmodel.Return)
+	return &orderReturn, args.Error(1)
+}
+
+func (m *StubReturnRepo) FindForOrder(orderID uuid.UUID) ([]domainmodel.Return, error) {
+	args := m.Called(orderID)
+	return args.Get(0).([]domainmodel.Return), args.Error(1)
+}
+
+func TestReturnAppService_ApproveReturn(t *testing.T) {
+	returnID := uuid.New()
+	orderID := uuid.New()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	orderRepo := new(StubOrderRepo)
+	returnRepo := new(StubReturnRepo)
+	provider.On("OrderRepository", mock.Anything).Return(orderRepo)
+	provider.On("ReturnRepository", mock.Anything).Return(returnRepo)
+	returnRepo.On("Find", returnID).Return(&domainmodel.Return{
+		ReturnID:     returnID,
+		OrderID:      orderID,
+		UserID:       userID,
+		Items:        []domainmodel.ReturnItem{{ProductID: productID, Quantity: 1}},
+		RefundAmount: 270,
+		Status:       domainmodel.ReturnRequested,
+	}, nil).Once()
+	returnRepo.On("Store", mock.MatchedBy(func(r domainmodel.Return) bool {
+		return r.Status == domainmodel.ReturnApproved
+	})).Return(nil).Once()
+	returnRepo.On("Find", returnID).Return(&domainmodel.Return{
+		ReturnID:     returnID,
+		OrderID:      orderID,
+		UserID:       userID,
+		Items:        []domainmodel.ReturnItem{{ProductID: productID, Quantity: 1}},
+		RefundAmount: 270,
+		Status:       domainmodel.ReturnApproved,
+	}, nil).Once()
+
+	workflowDispatcher := &RecordingDispatcher{}
+	service := NewReturnService(&PassThroughLockableUnitOfWork{provider: provider}, &DummyDispatcher{}, workflowDispatcher)
+
+	err := service.ApproveReturn(context.Background(), returnID)
+	assert.NoError(t, err)
+	returnRepo.AssertExpectations(t)
+	// workflow возврата запускается в той же транзакции, что и одобрение
+	assert.Equal(t, []outbox.Event{&model.StartReturnOrderWorkflow{
+		ReturnID:     returnID,
+		OrderID:      orderID,
+		UserID:       userID,
+		Items:        []model.OrderItem{{ProductID: productID, Quantity: 1}},
+		RefundAmount: 270,
+	}}, workflowDispatcher.events)
+}
@@ -8,8 +8,16 @@ import (
 
 type RepositoryProvider interface {
 	OrderRepository(ctx context.Context) model.OrderRepository
+	StatusTransitionRepository(ctx context.Context) model.StatusTransitionRepository
 	LocalUserRepository(ctx context.Context) model.LocalUserRepository
 	LocalProductRepository(ctx context.Context) model.LocalProductRepository
+	IdempotencyKeyRepository(ctx context.Context) model.IdempotencyKeyRepository
+	CartRepository(ctx context.Context) model.CartRepository
+	ReturnRepository(ctx context.Context) model.ReturnRepository
+	PromoCodeRepository(ctx context.Context) model.PromoCodeRepository
+	PromoRedemptionRepository(ctx context.Context) model.PromoRedemptionRepository
+	ReportRepository(ctx context.Context) model.ReportRepository
+	RecurringOrderRepository(ctx context.Context) model.RecurringOrderRepository
 }
 
 type LockableUnitOfWork interface {
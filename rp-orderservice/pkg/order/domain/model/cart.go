@@ -0,0 +1,35 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCartNotFound     = errors.New("cart not found")
+	ErrCartItemNotFound = errors.New("product is not in cart")
+	ErrEmptyCart        = errors.New("cart is empty")
+	ErrInvalidQuantity  = errors.New("quantity must be positive")
+)
+
+// Cart - корзина пользователя, у каждого пользователя она одна.
+// Цены в корзине не хранятся: их всегда показываем актуальными из local_product
+type Cart struct {
+	UserID    uuid.UUID
+	Items     []CartItem
+	UpdatedAt time.Time
+}
+
+// CartItem - строка корзины. Товар встречается в корзине не больше одного раза
+type CartItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+}
+
+type CartRepository interface {
+	Store(cart Cart) error
+	Find(userID uuid.UUID) (*Cart, error)
+	Delete(userID uuid.UUID) error
+}
@@ -10,6 +10,8 @@ type OrderCreated struct {
 	OrderID    uuid.UUID
 	UserID     uuid.UUID
 	TotalPrice int64
+	Discount   int64
+	PromoCode  string
 	Items      []OrderItem
 	CreatedAt  time.Time
 }
@@ -28,11 +30,48 @@ func (e OrderPaid) Type() string {
 }
 
 type OrderCancelled struct {
-	OrderID     uuid.UUID
-	Reason      string
+	OrderID uuid.UUID
+	Reason  string
+	// Refunded - заказ был оплачен, и деньги за него вернули
+	Refunded    bool
 	CancelledAt time.Time
 }
 
 func (e OrderCancelled) Type() string {
 	return "order_cancelled"
 }
+
+type OrderShipped struct {
+	OrderID        uuid.UUID
+	UserID         uuid.UUID
+	Carrier        string
+	TrackingNumber string
+	ShippedAt      time.Time
+}
+
+func (e OrderShipped) Type() string {
+	return "order_shipped"
+}
+
+type OrderDelivered struct {
+	OrderID     uuid.UUID
+	UserID      uuid.UUID
+	DeliveredAt time.Time
+}
+
+func (e OrderDelivered) Type() string {
+	return "order_delivered"
+}
+
+type OrderReturned struct {
+	OrderID      uuid.UUID
+	ReturnID     uuid.UUID
+	UserID       uuid.UUID
+	Items        []ReturnItem
+	RefundAmount int64
+	ReturnedAt   time.Time
+}
+
+func (e OrderReturned) Type() string {
+	return "order_returned"
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+	ErrIdempotencyKeyConflict = errors.New("idempotency key was already used with different request")
+	ErrInvalidIdempotencyKey  = errors.New("idempotency key is too long")
+)
+
+const MaxIdempotencyKeyLength = 128
+
+// IdempotencyKey связывает ключ, присланный клиентом, с созданным по нему заказом.
+// Ключи уникальны в пределах пользователя
+type IdempotencyKey struct {
+	UserID uuid.UUID
+	Key    string
+	// RequestHash - отпечаток содержимого запроса, чтобы отличить ретрай от другого запроса с тем же ключом
+	RequestHash string
+	OrderID     uuid.UUID
+	CreatedAt   time.Time
+}
+
+type IdempotencyKeyRepository interface {
+	Store(key IdempotencyKey) error
+	Find(userID uuid.UUID, key string) (*IdempotencyKey, error)
+}
@@ -1,27 +1,62 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
+type LocalUserStatus int
+
+const (
+	LocalUserActive LocalUserStatus = iota
+	LocalUserBlocked
+)
+
+// Причины, с которыми отменяются неоплаченные заказы пользователя
+const (
+	CancelReasonUserBlocked = "User was blocked"
+	CancelReasonUserDeleted = "User was deleted"
+)
+
 type LocalUser struct {
 	UserID uuid.UUID
 	Login  string
+	// Status - заблокированный пользователь не может оформлять заказы
+	Status LocalUserStatus
+	// DeletedAt проставляется по user_deleted, удаленный пользователь не может оформлять заказы
+	DeletedAt *time.Time
 }
 
 type LocalUserRepository interface {
+	// Store не трогает удаленных пользователей, чтобы повторно доставленный user_created их не воскресил
 	Store(user LocalUser) error
 	Find(userID uuid.UUID) (*LocalUser, error)
+	MarkDeleted(userID uuid.UUID, deletedAt time.Time) error
+	// UpdateStatus меняет статус по user_updated, которое может обогнать user_created.
+	// Статус из события старше уже примененного не применяется
+	UpdateStatus(userID uuid.UUID, status LocalUserStatus, updatedAt time.Time) error
+	// Anonymize стирает персональные данные при жестком удалении пользователя
+	Anonymize(userID uuid.UUID) error
 }
 
 type LocalProduct struct {
-	ProductID uuid.UUID
-	Name      string
-	Price     int64
+	ProductID   uuid.UUID
+	Name        string
+	Description *string
+	Price       int64
+	// Version - версия снимка из productservice, более старые снимки не перетирают новые
+	Version int64
+	// DeletedAt проставляется по product_deleted, удаленный товар нельзя заказать
+	DeletedAt *time.Time
 }
 
 type LocalProductRepository interface {
+	// Store применяет снимок, только если его версия новее сохраненной
 	Store(product LocalProduct) error
 	Find(productID uuid.UUID) (*LocalProduct, error)
 	FindMany(productIDs []uuid.UUID) ([]LocalProduct, error)
+	// MarkDeleted помечает товар удаленным, если версия удаления новее сохраненной.
+	// Товара может еще не быть в проекции - тогда остается пустая удаленная запись
+	MarkDeleted(productID uuid.UUID, version int64, deletedAt time.Time) error
 }
@@ -11,7 +11,13 @@ var (
 	ErrOrderNotFound   = errors.New("order not found")
 	ErrProductNotFound = errors.New("product for order not found")
 	ErrUserNotFound    = errors.New("user for order not found")
+	ErrProductDeleted  = errors.New("product for order was deleted")
+	ErrUserDeleted     = errors.New("user for order was deleted")
+	ErrUserBlocked     = errors.New("user for order is blocked")
 	ErrEmptyOrder      = errors.New("order must contain at least one item")
+	ErrInvalidStatus   = errors.New("order status does not allow this operation")
+	ErrInvalidShipment = errors.New("carrier and tracking number are required")
+	ErrInvalidDiscount = errors.New("discount exceeds item value")
 )
 
 type OrderStatus int
@@ -21,20 +27,38 @@ const (
 	StatusPaymentPending
 	StatusPaid
 	StatusCancelled
+	StatusShipped
+	StatusDelivered
+	StatusCompleted
+	// StatusAwaitingApproval - крупный заказ ждет ручного одобрения, товары уже зарезервированы
+	StatusAwaitingApproval
 )
 
+// UnpaidStatuses - заказ еще не оплачен, и его можно отменить без возврата денег
+var UnpaidStatuses = []OrderStatus{StatusCreated, StatusAwaitingApproval, StatusPaymentPending}
+
 type OrderItem struct {
 	ProductID uuid.UUID
 	Quantity  int
 	Price     int64 // Цена за единицу в копейках на момент заказа
+	Discount  int64 // Скидка на всю позицию в копейках
+}
+
+// Shipment - данные об отправке, появляются при переходе в StatusShipped
+type Shipment struct {
+	Carrier        string
+	TrackingNumber string
 }
 
 type Order struct {
 	OrderID    uuid.UUID
 	UserID     uuid.UUID
 	Items      []OrderItem
-	TotalPrice int64 // Общая цена в копейках
+	TotalPrice int64 // Общая цена в копейках с учетом скидки
+	Discount   int64 // Скидка на заказ, сумма скидок по позициям
+	PromoCode  string
 	Status     OrderStatus
+	Shipment   *Shipment
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -43,4 +67,5 @@ type OrderRepository interface {
 	NextID() (uuid.UUID, error)
 	Store(order Order) error
 	Find(orderID uuid.UUID) (*Order, error)
+	FindIDsByUser(userID uuid.UUID, statuses []OrderStatus) ([]uuid.UUID, error)
 }
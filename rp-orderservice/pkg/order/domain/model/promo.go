@@ -0,0 +1,75 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const MaxPromoCodeLength = 64
+
+var (
+	ErrPromoCodeNotFound      = errors.New("promo code not found")
+	ErrPromoCodeAlreadyExists = errors.New("promo code already exists")
+	ErrInvalidPromoCode       = errors.New("invalid promo code definition")
+	ErrPromoCodeNotActive     = errors.New("promo code is not active")
+	ErrPromoCodeMinOrderValue = errors.New("order value is below promo code minimum")
+	ErrPromoCodeUsageLimit    = errors.New("promo code usage limit reached")
+	ErrPromoCodeUserLimit     = errors.New("promo code usage limit for user reached")
+)
+
+type DiscountType int
+
+const (
+	DiscountPercent DiscountType = iota
+	DiscountFixed
+)
+
+type PromoCode struct {
+	Code string
+	Type DiscountType
+	// Value - процент для DiscountPercent или сумма в копейках для DiscountFixed
+	Value int64
+	// MinOrderValue - минимальная сумма заказа до скидки в копейках
+	MinOrderValue int64
+	// UsageLimit и PerUserLimit - сколько раз код можно применить всего и одному пользователю, 0 - без ограничений
+	UsageLimit   int
+	PerUserLimit int
+	// ValidFrom и ValidTo - окно действия кода, nil - без границы
+	ValidFrom *time.Time
+	ValidTo   *time.Time
+	CreatedAt time.Time
+}
+
+// IsActive проверяет, попадает ли момент в окно действия кода
+func (p PromoCode) IsActive(at time.Time) bool {
+	if p.ValidFrom != nil && at.Before(*p.ValidFrom) {
+		return false
+	}
+	if p.ValidTo != nil && !at.Before(*p.ValidTo) {
+		return false
+	}
+	return true
+}
+
+// PromoRedemption - применение кода к заказу. При отмене заказа применение освобождается и не идет в лимиты
+type PromoRedemption struct {
+	Code       string
+	OrderID    uuid.UUID
+	UserID     uuid.UUID
+	RedeemedAt time.Time
+	ReleasedAt *time.Time
+}
+
+type PromoCodeRepository interface {
+	Store(promo PromoCode) error
+	Find(code string) (*PromoCode, error)
+}
+
+type PromoRedemptionRepository interface {
+	Store(redemption PromoRedemption) error
+	FindForOrder(orderID uuid.UUID) (*PromoRedemption, error)
+	// CountActive считает неосвобожденные применения кода, с userID - только применения этого пользователя
+	CountActive(code string, userID *uuid.UUID) (int, error)
+}
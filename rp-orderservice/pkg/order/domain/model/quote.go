@@ -0,0 +1,29 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidQuote = errors.New("quote token is invalid or does not match the order")
+	ErrQuoteExpired = errors.New("quote has expired")
+)
+
+// Quote - цены заказа, зафиксированные на время. В базе не хранится: клиент получает ее в подписанном токене.
+// Скидка по промокоду в котировке не хранится, она пересчитывается от зафиксированных цен.
+// Токен можно предъявить повторно до ExpiresAt, и это допустимо: он фиксирует только цену, а каждый заказ по нему
+// отдельно резервирует остатки, оплачивается и расходует промокод по его лимитам. От двойной отправки защищает IdempotencyKey
+type Quote struct {
+	UserID     uuid.UUID
+	Items      []OrderItem
+	PromoCode  string
+	TotalPrice int64 // Итог с учетом скидки, заказ по котировке обязан совпасть с ним
+	ExpiresAt  time.Time
+}
+
+func (q Quote) IsExpired(at time.Time) bool {
+	return !at.Before(q.ExpiresAt)
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRecurringOrderNotFound       = errors.New("recurring order not found")
+	ErrRecurringRunNotFound         = errors.New("recurring order run not found")
+	ErrInvalidRecurringInterval     = errors.New("recurring order interval is too short")
+	ErrInvalidRecurringOrderStatus  = errors.New("recurring order status does not allow this operation")
+	ErrInvalidRecurringItemQuantity = errors.New("recurring order item quantity must be positive")
+)
+
+// MinRecurringInterval - чаще раза в час регулярные заказы не создаются
+const MinRecurringInterval = time.Hour
+
+// CancelReasonInsufficientFunds - причина отмены заказа, за который не хватило денег, а окно оплаты не ждали
+const CancelReasonInsufficientFunds = "Insufficient funds"
+
+type RecurringOrderStatus int
+
+const (
+	RecurringActive RecurringOrderStatus = iota
+	RecurringPaused
+	RecurringCancelled
+)
+
+// RecurringOrder - заказ, который создается по расписанию раз в Interval с одними и теми же позициями
+type RecurringOrder struct {
+	RecurringOrderID uuid.UUID
+	UserID           uuid.UUID
+	Items            []OrderItem // Только товар и количество, цены берутся на момент запуска
+	Interval         time.Duration
+	Status           RecurringOrderStatus
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type RecurringRunStatus int
+
+const (
+	// RunPlaced - заказ создан, сага еще не закончилась
+	RunPlaced RecurringRunStatus = iota
+	RunPaid
+	// RunSkipped - денег не хватило, запуск пропущен до следующего раза
+	RunSkipped
+	RunFailed
+)
+
+// RecurringOrderRun - запуск регулярного заказа. OrderID пустой, если заказ создать не удалось
+type RecurringOrderRun struct {
+	RunID            uuid.UUID
+	RecurringOrderID uuid.UUID
+	OrderID          *uuid.UUID
+	Status           RecurringRunStatus
+	Error            string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type RecurringOrderRepository interface {
+	NextID() (uuid.UUID, error)
+	Store(recurringOrder RecurringOrder) error
+	Find(recurringOrderID uuid.UUID) (*RecurringOrder, error)
+	StoreRun(run RecurringOrderRun) error
+	FindRunForOrder(orderID uuid.UUID) (*RecurringOrderRun, error)
+}
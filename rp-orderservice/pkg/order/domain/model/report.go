@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DailySales - изменение дневного агрегата продаж. Возврат денег за отмененный заказ
+// учитывается в день отмены, а возврат товаров - в день возврата. Выручку дня оплаты они не уменьшают
+type DailySales struct {
+	PaidOrders     int
+	Revenue        int64
+	RefundedOrders int
+	Refunds        int64
+}
+
+// ProductSales - изменение продаж товара за день. При возврате денег количество и выручка отрицательные
+type ProductSales struct {
+	ProductID uuid.UUID
+	Quantity  int
+	Revenue   int64
+}
+
+// ReportRepository копит агрегаты для отчетов, значения прибавляются к уже накопленным за день
+type ReportRepository interface {
+	AddDailySales(day time.Time, sales DailySales) error
+	AddProductSales(day time.Time, sales []ProductSales) error
+	AddStatusCount(day time.Time, status OrderStatus, count int) error
+}
+
+// ReportDay - день отчета, к которому относится событие. Дни считаются по UTC
+func ReportDay(at time.Time) time.Time {
+	return at.UTC().Truncate(24 * time.Hour)
+}
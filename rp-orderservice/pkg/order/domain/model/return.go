@@ -0,0 +1,52 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReturnNotFound         = errors.New("return not found")
+	ErrEmptyReturn            = errors.New("return must contain at least one item")
+	ErrReturnItemNotInOrder   = errors.New("returned product is not in order")
+	ErrReturnQuantityExceeded = errors.New("returned quantity exceeds quantity left in order")
+	ErrInvalidReturnStatus    = errors.New("return status does not allow this operation")
+)
+
+type ReturnStatus int
+
+const (
+	ReturnRequested ReturnStatus = iota
+	ReturnApproved
+	ReturnRejected
+	ReturnCompleted
+)
+
+type ReturnItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+}
+
+// Return - заявка на возврат части позиций доставленного заказа. Сам заказ при этом не меняется
+type Return struct {
+	ReturnID uuid.UUID
+	OrderID  uuid.UUID
+	UserID   uuid.UUID
+	Items    []ReturnItem
+	// RefundAmount - доля суммы заказа, приходящаяся на возвращаемые позиции, в копейках
+	RefundAmount int64
+	Status       ReturnStatus
+	// Reason - причина возврата от клиента, после отклонения - причина отказа
+	Reason    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ReturnRepository interface {
+	NextID() (uuid.UUID, error)
+	Store(orderReturn Return) error
+	Find(returnID uuid.UUID) (*Return, error)
+	FindForOrder(orderID uuid.UUID) ([]Return, error)
+}
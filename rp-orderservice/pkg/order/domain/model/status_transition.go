@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Actor string
+
+const (
+	ActorWorkflow Actor = "workflow"
+	ActorCustomer Actor = "customer"
+	ActorAdmin    Actor = "admin"
+	// ActorSystem - переход по событию другого сервиса, например отмена заказов заблокированного пользователя
+	ActorSystem Actor = "system"
+)
+
+// orderTransitions - допустимые переходы между статусами заказа.
+// Оплаченный заказ отменяется только после возврата денег, это проверяет сервис
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	StatusCreated:          {StatusPaymentPending, StatusAwaitingApproval, StatusCancelled},
+	StatusAwaitingApproval: {StatusPaymentPending, StatusCancelled},
+	// заказ, дождавшийся пополнения баланса, еще может уйти на ручное одобрение
+	StatusPaymentPending: {StatusAwaitingApproval, StatusPaid, StatusCancelled},
+	StatusPaid:           {StatusShipped, StatusCancelled},
+	StatusShipped:        {StatusDelivered},
+	StatusDelivered:      {StatusCompleted},
+}
+
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusTransition - запись в истории статусов заказа. From пустой у записи о создании заказа
+type StatusTransition struct {
+	OrderID    uuid.UUID
+	From       *OrderStatus
+	To         OrderStatus
+	Reason     string
+	Actor      Actor
+	OccurredAt time.Time
+}
+
+type StatusTransitionRepository interface {
+	Append(transition StatusTransition) error
+}
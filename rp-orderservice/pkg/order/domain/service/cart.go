@@ -0,0 +1,121 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type CartService interface {
+	// AddItem добавляет товар в корзину, для уже лежащего товара увеличивает количество
+	AddItem(userID, productID uuid.UUID, quantity int) error
+	UpdateQuantity(userID, productID uuid.UUID, quantity int) error
+	RemoveItem(userID, productID uuid.UUID) error
+	Clear(userID uuid.UUID) error
+}
+
+func NewCartService(cartRepo model.CartRepository) CartService {
+	return &cartService{
+		cartRepository: cartRepo,
+	}
+}
+
+type cartService struct {
+	cartRepository model.CartRepository
+}
+
+func (s *cartService) AddItem(userID, productID uuid.UUID, quantity int) error {
+	if quantity <= 0 {
+		return model.ErrInvalidQuantity
+	}
+
+	cart, err := s.cartRepository.Find(userID)
+	if errors.Is(err, model.ErrCartNotFound) {
+		cart = &model.Cart{UserID: userID}
+	} else if err != nil {
+		return err
+	}
+
+	merged := false
+	for i, item := range cart.Items {
+		if item.ProductID == productID {
+			cart.Items[i].Quantity += quantity
+			merged = true
+			break
+		}
+	}
+	if !merged {
+		cart.Items = append(cart.Items, model.CartItem{
+			ProductID: productID,
+			Quantity:  quantity,
+		})
+	}
+
+	cart.UpdatedAt = time.Now()
+	return s.cartRepository.Store(*cart)
+}
+
+func (s *cartService) UpdateQuantity(userID, productID uuid.UUID, quantity int) error {
+	if quantity <= 0 {
+		return model.ErrInvalidQuantity
+	}
+
+	cart, err := s.findItem(userID, productID)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range cart.Items {
+		if item.ProductID == productID {
+			cart.Items[i].Quantity = quantity
+		}
+	}
+
+	cart.UpdatedAt = time.Now()
+	return s.cartRepository.Store(*cart)
+}
+
+func (s *cartService) RemoveItem(userID, productID uuid.UUID) error {
+	cart, err := s.findItem(userID, productID)
+	if err != nil {
+		return err
+	}
+
+	items := make([]model.CartItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		if item.ProductID != productID {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return s.cartRepository.Delete(userID)
+	}
+
+	cart.Items = items
+	cart.UpdatedAt = time.Now()
+	return s.cartRepository.Store(*cart)
+}
+
+func (s *cartService) Clear(userID uuid.UUID) error {
+	return s.cartRepository.Delete(userID)
+}
+
+func (s *cartService) findItem(userID, productID uuid.UUID) (*model.Cart, error) {
+	cart, err := s.cartRepository.Find(userID)
+	if errors.Is(err, model.ErrCartNotFound) {
+		return nil, model.ErrCartItemNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range cart.Items {
+		if item.ProductID == productID {
+			return cart, nil
+		}
+	}
+	return nil, model.ErrCartItemNotFound
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type InMemoryCartRepository struct {
+	carts map[uuid.UUID]model.Cart
+}
+
+func (r *InMemoryCartRepository) Store(cart model.Cart) error {
+	r.carts[cart.UserID] = cart
+	return nil
+}
+
+func (r *InMemoryCartRepository) Find(userID uuid.UUID) (*model.Cart, error) {
+	cart, ok := r.carts[userID]
+	if !ok {
+		return nil, model.ErrCartNotFound
+	}
+	cart.Items = append([]model.CartItem(nil), cart.Items...)
+	return &cart, nil
+}
+
+func (r *InMemoryCartRepository) Delete(userID uuid.UUID) error {
+	delete(r.carts, userID)
+	return nil
+}
+
+func TestCartService_AddItem(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	otherProductID := uuid.New()
+
+	t.Run("duplicate product is merged", func(t *testing.T) {
+		repo := &InMemoryCartRepository{carts: map[uuid.UUID]model.Cart{}}
+		service := NewCartService(repo)
+
+		assert.NoError(t, service.AddItem(userID, productID, 1))
+		assert.NoError(t, service.AddItem(userID, otherProductID, 1))
+		assert.NoError(t, service.AddItem(userID, productID, 2))
+
+		assert.Equal(t, []model.CartItem{
+			{ProductID: productID, Quantity: 3},
+			{ProductID: otherProductID, Quantity: 1},
+		}, repo.carts[userID].Items)
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		repo := &InMemoryCartRepository{carts: map[uuid.UUID]model.Cart{}}
+		err := NewCartService(repo).AddItem(userID, productID, 0)
+		assert.ErrorIs(t, err, model.ErrInvalidQuantity)
+	})
+}
+
+func TestCartService_UpdateAndRemove(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	repo := &InMemoryCartRepository{carts: map[uuid.UUID]model.Cart{}}
+	service := NewCartService(repo)
+
+	assert.ErrorIs(t, service.UpdateQuantity(userID, productID, 2), model.ErrCartItemNotFound)
+
+	assert.NoError(t, service.AddItem(userID, productID, 1))
+	assert.NoError(t, service.UpdateQuantity(userID, productID, 5))
+	assert.Equal(t, 5, repo.carts[userID].Items[0].Quantity)
+
+	// последняя строка удалена - корзины больше нет
+	assert.NoError(t, service.RemoveItem(userID, productID))
+	_, ok := repo.carts[userID]
+	assert.False(t, ok)
+	assert.ErrorIs(t, service.RemoveItem(userID, productID), model.ErrCartItemNotFound)
+}
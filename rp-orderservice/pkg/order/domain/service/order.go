@@ -10,47 +10,65 @@ import (
 )
 
 type OrderService interface {
-	CreateOrder(userID uuid.UUID, items []model.OrderItem) (uuid.UUID, error)
+	// CreateOrder создает заказ. Скидки в позициях уже рассчитаны PromoService, promoCode - код, по которому они даны
+	CreateOrder(userID uuid.UUID, items []model.OrderItem, promoCode string) (uuid.UUID, error)
+	MarkAsAwaitingApproval(orderID uuid.UUID) error
+	MarkAsPaymentPending(orderID uuid.UUID) error
 	MarkAsPaid(orderID uuid.UUID) error
-	CancelOrder(orderID uuid.UUID, reason string) error
+	// CancelOrder отменяет неоплаченный заказ. actor - кто стал причиной отмены
+	CancelOrder(orderID uuid.UUID, reason string, actor model.Actor) error
+	CancelAfterRefund(orderID uuid.UUID, reason string, actor model.Actor) error
+	MarkAsShipped(orderID uuid.UUID, shipment model.Shipment) error
+	MarkAsDelivered(orderID uuid.UUID) error
+	Complete(orderID uuid.UUID) error
 }
 
 func NewOrderService(
 	orderRepo model.OrderRepository,
+	transitionRepo model.StatusTransitionRepository,
 	eventDispatcher domain.EventDispatcher,
 ) OrderService {
 	return &orderService{
-		orderRepository: orderRepo,
-		eventDispatcher: eventDispatcher,
+		orderRepository:      orderRepo,
+		transitionRepository: transitionRepo,
+		eventDispatcher:      eventDispatcher,
 	}
 }
 
 type orderService struct {
-	orderRepository model.OrderRepository
-	eventDispatcher domain.EventDispatcher
+	orderRepository      model.OrderRepository
+	transitionRepository model.StatusTransitionRepository
+	eventDispatcher      domain.EventDispatcher
 }
 
-func (s *orderService) CreateOrder(userID uuid.UUID, items []model.OrderItem) (uuid.UUID, error) {
+func (s *orderService) CreateOrder(userID uuid.UUID, items []model.OrderItem, promoCode string) (uuid.UUID, error) {
 	if len(items) == 0 {
 		return uuid.Nil, model.ErrEmptyOrder
 	}
 
+	var totalPrice, discount int64
+	for _, item := range items {
+		value := item.Price * int64(item.Quantity)
+		if item.Discount < 0 || item.Discount > value {
+			return uuid.Nil, model.ErrInvalidDiscount
+		}
+		totalPrice += value - item.Discount
+		discount += item.Discount
+	}
+
 	orderID, err := s.orderRepository.NextID()
 	if err != nil {
 		return uuid.Nil, err
 	}
 
-	var totalPrice int64
-	for _, item := range items {
-		totalPrice += item.Price * int64(item.Quantity)
-	}
-
 	currentTime := time.Now()
 	order := model.Order{
 		OrderID:    orderID,
 		UserID:     userID,
 		Items:      items,
 		TotalPrice: totalPrice,
+		Discount:   discount,
+		PromoCode:  promoCode,
 		Status:     model.StatusCreated,
 		CreatedAt:  currentTime,
 		UpdatedAt:  currentTime,
@@ -60,17 +78,55 @@ func (s *orderService) CreateOrder(userID uuid.UUID, items []model.OrderItem) (u
 		return uuid.Nil, err
 	}
 
+	err = s.transitionRepository.Append(model.StatusTransition{
+		OrderID:    orderID,
+		To:         model.StatusCreated,
+		Actor:      model.ActorCustomer,
+		OccurredAt: currentTime,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
 	// орем, что заказ создан
 
 	return orderID, s.eventDispatcher.Dispatch(&model.OrderCreated{
 		OrderID:    orderID,
 		UserID:     userID,
 		TotalPrice: totalPrice,
+		Discount:   discount,
+		PromoCode:  promoCode,
 		Items:      items,
 		CreatedAt:  currentTime,
 	})
 }
 
+func (s *orderService) MarkAsAwaitingApproval(orderID uuid.UUID) error {
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == model.StatusAwaitingApproval {
+		return nil
+	}
+
+	return s.transition(order, model.StatusAwaitingApproval, "", model.ActorWorkflow)
+}
+
+func (s *orderService) MarkAsPaymentPending(orderID uuid.UUID) error {
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == model.StatusPaymentPending {
+		return nil
+	}
+
+	return s.transition(order, model.StatusPaymentPending, "", model.ActorWorkflow)
+}
+
 func (s *orderService) MarkAsPaid(orderID uuid.UUID) error {
 	order, err := s.orderRepository.Find(orderID)
 	if err != nil {
@@ -81,10 +137,8 @@ func (s *orderService) MarkAsPaid(orderID uuid.UUID) error {
 		return nil
 	}
 
-	order.Status = model.StatusPaid
-	order.UpdatedAt = time.Now()
-
-	if err := s.orderRepository.Store(*order); err != nil {
+	err = s.transition(order, model.StatusPaid, "", model.ActorWorkflow)
+	if err != nil {
 		return err
 	}
 
@@ -94,28 +148,139 @@ func (s *orderService) MarkAsPaid(orderID uuid.UUID) error {
 	})
 }
 
-func (s *orderService) CancelOrder(orderID uuid.UUID, reason string) error {
+func (s *orderService) CancelOrder(orderID uuid.UUID, reason string, actor model.Actor) error {
 	order, err := s.orderRepository.Find(orderID)
 	if err != nil {
 		return err
 	}
 
+	// компенсация не отменяет оплаченный заказ: для него нужен возврат денег
 	if order.Status == model.StatusCancelled || order.Status == model.StatusPaid {
 		return nil
 	}
 
-	order.Status = model.StatusCancelled
-	order.UpdatedAt = time.Now()
+	return s.cancel(order, reason, actor)
+}
+
+// CancelAfterRefund отменяет заказ по запросу, в том числе оплаченный. actor - кто запросил отмену.
+// Вызывается после того, как деньги возвращены и резерв снят
+func (s *orderService) CancelAfterRefund(orderID uuid.UUID, reason string, actor model.Actor) error {
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
 
-	if err := s.orderRepository.Store(*order); err != nil {
+	if order.Status == model.StatusCancelled {
+		return nil
+	}
+
+	return s.cancel(order, reason, actor)
+}
+
+func (s *orderService) MarkAsShipped(orderID uuid.UUID, shipment model.Shipment) error {
+	if shipment.Carrier == "" || shipment.TrackingNumber == "" {
+		return model.ErrInvalidShipment
+	}
+
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == model.StatusShipped {
+		return nil
+	}
+
+	order.Shipment = &shipment
+	err = s.transition(order, model.StatusShipped, "", model.ActorAdmin)
+	if err != nil {
+		return err
+	}
+
+	return s.eventDispatcher.Dispatch(&model.OrderShipped{
+		OrderID:        orderID,
+		UserID:         order.UserID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		ShippedAt:      order.UpdatedAt,
+	})
+}
+
+func (s *orderService) MarkAsDelivered(orderID uuid.UUID) error {
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == model.StatusDelivered {
+		return nil
+	}
+
+	err = s.transition(order, model.StatusDelivered, "", model.ActorAdmin)
+	if err != nil {
+		return err
+	}
+
+	return s.eventDispatcher.Dispatch(&model.OrderDelivered{
+		OrderID:     orderID,
+		UserID:      order.UserID,
+		DeliveredAt: order.UpdatedAt,
+	})
+}
+
+// Complete закрывает доставленный заказ, когда истек срок на претензии
+func (s *orderService) Complete(orderID uuid.UUID) error {
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == model.StatusCompleted {
+		return nil
+	}
+
+	return s.transition(order, model.StatusCompleted, "", model.ActorWorkflow)
+}
+
+func (s *orderService) cancel(order *model.Order, reason string, actor model.Actor) error {
+	refunded := order.Status == model.StatusPaid
+	err := s.transition(order, model.StatusCancelled, reason, actor)
+	if err != nil {
 		return err
 	}
 
 	// орем, что заказ отменен
 
 	return s.eventDispatcher.Dispatch(&model.OrderCancelled{
-		OrderID:     orderID,
+		OrderID:     order.OrderID,
 		Reason:      reason,
+		Refunded:    refunded,
 		CancelledAt: order.UpdatedAt,
 	})
 }
+
+// transition меняет статус по таблице переходов и пишет переход в историю.
+// Вызывается внутри транзакции, поэтому история не расходится со статусом
+func (s *orderService) transition(order *model.Order, to model.OrderStatus, reason string, actor model.Actor) error {
+	if !order.Status.CanTransitionTo(to) {
+		return model.ErrInvalidStatus
+	}
+
+	from := order.Status
+	order.Status = to
+	order.UpdatedAt = time.Now()
+
+	err := s.orderRepository.Store(*order)
+	if err != nil {
+		return err
+	}
+
+	return s.transitionRepository.Append(model.StatusTransition{
+		OrderID:    order.OrderID,
+		From:       &from,
+		To:         to,
+		Reason:     reason,
+		Actor:      actor,
+		OccurredAt: order.UpdatedAt,
+	})
+}
@@ -33,6 +33,26 @@ func (m *MockOrderRepository) Find(orderID uuid.UUID) (*model.Order, error) {
 	return args.Get(0).(*model.Order), args.Error(1)
 }
 
+func (m *MockOrderRepository) FindIDsByUser(userID uuid.UUID, statuses []model.OrderStatus) ([]uuid.UUID, error) {
+	args := m.Called(userID, statuses)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+type MockStatusTransitionRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatusTransitionRepository) Append(transition model.StatusTransition) error {
+	args := m.Called(transition)
+	return args.Error(0)
+}
+
+func newMockStatusTransitionRepository() *MockStatusTransitionRepository {
+	repo := new(MockStatusTransitionRepository)
+	repo.On("Append", mock.Anything).Return(nil)
+	return repo
+}
+
 type MockEventDispatcher struct {
 	mock.Mock
 }
@@ -45,7 +65,7 @@ func (m *MockEventDispatcher) Dispatch(event domain.Event) error {
 func TestOrderService_CreateOrder(t *testing.T) {
 	repo := new(MockOrderRepository)
 	dispatcher := new(MockEventDispatcher)
-	service := NewOrderService(repo, dispatcher)
+	service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
 
 	userID := uuid.New()
 	productID := uuid.New()
@@ -64,7 +84,7 @@ func TestOrderService_CreateOrder(t *testing.T) {
 			return e.OrderID == orderID && e.TotalPrice == 200
 		})).Return(nil).Once()
 
-		id, err := service.CreateOrder(userID, items)
+		id, err := service.CreateOrder(userID, items, "")
 		assert.NoError(t, err)
 		assert.Equal(t, orderID, id)
 		repo.AssertExpectations(t)
@@ -72,7 +92,7 @@ func TestOrderService_CreateOrder(t *testing.T) {
 	})
 
 	t.Run("empty order", func(t *testing.T) {
-		_, err := service.CreateOrder(userID, []model.OrderItem{})
+		_, err := service.CreateOrder(userID, []model.OrderItem{}, "")
 		assert.ErrorIs(t, err, model.ErrEmptyOrder)
 	})
 }
@@ -80,14 +100,14 @@ func TestOrderService_CreateOrder(t *testing.T) {
 func TestOrderService_MarkAsPaid(t *testing.T) {
 	repo := new(MockOrderRepository)
 	dispatcher := new(MockEventDispatcher)
-	service := NewOrderService(repo, dispatcher)
+	service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
 
 	orderID := uuid.New()
 
 	t.Run("success", func(t *testing.T) {
 		existingOrder := &model.Order{
 			OrderID: orderID,
-			Status:  model.StatusCreated,
+			Status:  model.StatusPaymentPending,
 		}
 
 		repo.On("Find", orderID).Return(existingOrder, nil).Once()
@@ -113,14 +133,22 @@ func TestOrderService_MarkAsPaid(t *testing.T) {
 
 		err := service.MarkAsPaid(orderID)
 		assert.NoError(t, err)
-		repo.AssertNotCalled(t, "Store")
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("payment was not started", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusCreated}, nil).Once()
+
+		err := service.MarkAsPaid(orderID)
+		assert.ErrorIs(t, err, model.ErrInvalidStatus)
+		repo.AssertNumberOfCalls(t, "Store", 1)
 	})
 }
 
 func TestOrderService_CancelOrder(t *testing.T) {
 	repo := new(MockOrderRepository)
 	dispatcher := new(MockEventDispatcher)
-	service := NewOrderService(repo, dispatcher)
+	service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
 
 	orderID := uuid.New()
 
@@ -138,7 +166,7 @@ func TestOrderService_CancelOrder(t *testing.T) {
 			return e.OrderID == orderID && e.Reason == "test"
 		})).Return(nil).Once()
 
-		err := service.CancelOrder(orderID, "test")
+		err := service.CancelOrder(orderID, "test", model.ActorWorkflow)
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
 	})
@@ -150,8 +178,277 @@ func TestOrderService_CancelOrder(t *testing.T) {
 		}
 		repo.On("Find", orderID).Return(existingOrder, nil).Once()
 
-		err := service.CancelOrder(orderID, "reason")
+		err := service.CancelOrder(orderID, "reason", model.ActorWorkflow)
 		assert.NoError(t, err)
 		repo.AssertNotCalled(t, "Store")
 	})
 }
+
+func TestOrderService_CancelAfterRefund(t *testing.T) {
+	repo := new(MockOrderRepository)
+	dispatcher := new(MockEventDispatcher)
+	transitionRepo := new(MockStatusTransitionRepository)
+	service := NewOrderService(repo, transitionRepo, dispatcher)
+
+	orderID := uuid.New()
+
+	t.Run("paid order", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusPaid}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.OrderID == orderID && o.Status == model.StatusCancelled
+		})).Return(nil).Once()
+		transitionRepo.On("Append", mock.MatchedBy(func(tr model.StatusTransition) bool {
+			return tr.OrderID == orderID && tr.To == model.StatusCancelled && tr.Actor == model.ActorCustomer
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.OrderCancelled) bool {
+			return e.OrderID == orderID && e.Reason == "changed mind"
+		})).Return(nil).Once()
+
+		err := service.CancelAfterRefund(orderID, "changed mind", model.ActorCustomer)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		transitionRepo.AssertExpectations(t)
+		dispatcher.AssertExpectations(t)
+	})
+
+	t.Run("cancelled by system", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusPaymentPending}, nil).Once()
+		repo.On("Store", mock.Anything).Return(nil).Once()
+		transitionRepo.On("Append", mock.MatchedBy(func(tr model.StatusTransition) bool {
+			return tr.OrderID == orderID && tr.Actor == model.ActorSystem && tr.Reason == model.CancelReasonUserBlocked
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.OrderCancelled")).Return(nil).Once()
+
+		err := service.CancelAfterRefund(orderID, model.CancelReasonUserBlocked, model.ActorSystem)
+		assert.NoError(t, err)
+		transitionRepo.AssertExpectations(t)
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusCancelled}, nil).Once()
+
+		err := service.CancelAfterRefund(orderID, "changed mind", model.ActorCustomer)
+		assert.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Store", 2)
+	})
+}
+
+func TestOrderService_MarkAsPaymentPending(t *testing.T) {
+	repo := new(MockOrderRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
+
+	orderID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		existingOrder := &model.Order{
+			OrderID: orderID,
+			Status:  model.StatusCreated,
+		}
+
+		repo.On("Find", orderID).Return(existingOrder, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.OrderID == orderID && o.Status == model.StatusPaymentPending
+		})).Return(nil).Once()
+
+		err := service.MarkAsPaymentPending(orderID)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("already pending", func(t *testing.T) {
+		existingOrder := &model.Order{
+			OrderID: orderID,
+			Status:  model.StatusPaymentPending,
+		}
+		repo.On("Find", orderID).Return(existingOrder, nil).Once()
+
+		err := service.MarkAsPaymentPending(orderID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("cancelled order", func(t *testing.T) {
+		existingOrder := &model.Order{
+			OrderID: orderID,
+			Status:  model.StatusCancelled,
+		}
+		repo.On("Find", orderID).Return(existingOrder, nil).Once()
+
+		err := service.MarkAsPaymentPending(orderID)
+		assert.ErrorIs(t, err, model.ErrInvalidStatus)
+	})
+}
+
+func TestOrderService_MarkAsAwaitingApproval(t *testing.T) {
+	repo := new(MockOrderRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
+
+	orderID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{
+			OrderID: orderID,
+			Status:  model.StatusCreated,
+		}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.OrderID == orderID && o.Status == model.StatusAwaitingApproval
+		})).Return(nil).Once()
+
+		err := service.MarkAsAwaitingApproval(orderID)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("approved order goes to payment", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{
+			OrderID: orderID,
+			Status:  model.StatusAwaitingApproval,
+		}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.OrderID == orderID && o.Status == model.StatusPaymentPending
+		})).Return(nil).Once()
+
+		err := service.MarkAsPaymentPending(orderID)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cancelled order", func(t *testing.T) {
+		repo.On("Find", orderID).Return(&model.Order{
+			OrderID: orderID,
+			Status:  model.StatusCancelled,
+		}, nil).Once()
+
+		err := service.MarkAsAwaitingApproval(orderID)
+		assert.ErrorIs(t, err, model.ErrInvalidStatus)
+	})
+}
+
+func TestOrderService_StatusHistory(t *testing.T) {
+	repo := new(MockOrderRepository)
+	transitions := new(MockStatusTransitionRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewOrderService(repo, transitions, dispatcher)
+
+	orderID := uuid.New()
+	repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusPaymentPending}, nil).Once()
+	repo.On("Store", mock.Anything).Return(nil).Once()
+	dispatcher.On("Dispatch", mock.Anything).Return(nil).Once()
+	transitions.On("Append", mock.MatchedBy(func(transition model.StatusTransition) bool {
+		return transition.OrderID == orderID &&
+			transition.From != nil && *transition.From == model.StatusPaymentPending &&
+			transition.To == model.StatusCancelled &&
+			transition.Reason == "Payment failed" &&
+			transition.Actor == model.ActorWorkflow
+	})).Return(nil).Once()
+
+	err := service.CancelOrder(orderID, "Payment failed", model.ActorWorkflow)
+	assert.NoError(t, err)
+	transitions.AssertExpectations(t)
+}
+
+func TestOrderService_CancelOrderRecordsActor(t *testing.T) {
+	repo := new(MockOrderRepository)
+	transitions := new(MockStatusTransitionRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewOrderService(repo, transitions, dispatcher)
+
+	orderID := uuid.New()
+	repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusAwaitingApproval}, nil).Once()
+	repo.On("Store", mock.Anything).Return(nil).Once()
+	dispatcher.On("Dispatch", mock.Anything).Return(nil).Once()
+	// отказ в одобрении отменяет заказ через сагу, но в истории остается администратор
+	transitions.On("Append", mock.MatchedBy(func(transition model.StatusTransition) bool {
+		return transition.OrderID == orderID &&
+			transition.To == model.StatusCancelled &&
+			transition.Actor == model.ActorAdmin
+	})).Return(nil).Once()
+
+	err := service.CancelOrder(orderID, "Order rejected: fraud", model.ActorAdmin)
+	assert.NoError(t, err)
+	transitions.AssertExpectations(t)
+}
+
+func TestOrderService_Fulfilment(t *testing.T) {
+	userID := uuid.New()
+	orderID := uuid.New()
+	shipment := model.Shipment{Carrier: "CDEK", TrackingNumber: "1234567890"}
+
+	t.Run("ship paid order", func(t *testing.T) {
+		repo := new(MockOrderRepository)
+		dispatcher := new(MockEventDispatcher)
+		service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
+
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, UserID: userID, Status: model.StatusPaid}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.Status == model.StatusShipped && o.Shipment != nil && *o.Shipment == shipment
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.OrderShipped) bool {
+			return e.OrderID == orderID && e.UserID == userID && e.TrackingNumber == shipment.TrackingNumber
+		})).Return(nil).Once()
+
+		err := service.MarkAsShipped(orderID, shipment)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		dispatcher.AssertExpectations(t)
+	})
+
+	t.Run("ship without tracking number", func(t *testing.T) {
+		service := NewOrderService(new(MockOrderRepository), newMockStatusTransitionRepository(), new(MockEventDispatcher))
+
+		err := service.MarkAsShipped(orderID, model.Shipment{Carrier: "CDEK"})
+		assert.ErrorIs(t, err, model.ErrInvalidShipment)
+	})
+
+	t.Run("unpaid order cannot be shipped", func(t *testing.T) {
+		repo := new(MockOrderRepository)
+		service := NewOrderService(repo, newMockStatusTransitionRepository(), new(MockEventDispatcher))
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusPaymentPending}, nil).Once()
+
+		err := service.MarkAsShipped(orderID, shipment)
+		assert.ErrorIs(t, err, model.ErrInvalidStatus)
+	})
+
+	t.Run("deliver shipped order", func(t *testing.T) {
+		repo := new(MockOrderRepository)
+		dispatcher := new(MockEventDispatcher)
+		service := NewOrderService(repo, newMockStatusTransitionRepository(), dispatcher)
+
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, UserID: userID, Status: model.StatusShipped}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.Status == model.StatusDelivered
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.OrderDelivered) bool {
+			return e.OrderID == orderID && e.UserID == userID
+		})).Return(nil).Once()
+
+		err := service.MarkAsDelivered(orderID)
+		assert.NoError(t, err)
+		dispatcher.AssertExpectations(t)
+	})
+
+	t.Run("complete delivered order", func(t *testing.T) {
+		repo := new(MockOrderRepository)
+		service := NewOrderService(repo, newMockStatusTransitionRepository(), new(MockEventDispatcher))
+
+		repo.On("Find", orderID).Return(&model.Order{OrderID: orderID, Status: model.StatusDelivered}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(o model.Order) bool {
+			return o.Status == model.StatusCompleted
+		})).Return(nil).Once()
+
+		err := service.Complete(orderID)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestOrderStatus_CanTransitionTo(t *testing.T) {
+	assert.True(t, model.StatusCreated.CanTransitionTo(model.StatusPaymentPending))
+	assert.True(t, model.StatusPaid.CanTransitionTo(model.StatusCancelled))
+	assert.False(t, model.StatusCreated.CanTransitionTo(model.StatusPaid))
+	assert.False(t, model.StatusCancelled.CanTransitionTo(model.StatusPaymentPending))
+	assert.True(t, model.StatusAwaitingApproval.CanTransitionTo(model.StatusCancelled))
+	assert.False(t, model.StatusAwaitingApproval.CanTransitionTo(model.StatusPaid))
+	assert.True(t, model.StatusPaymentPending.CanTransitionTo(model.StatusAwaitingApproval))
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type PromoService interface {
+	CreatePromoCode(promo model.PromoCode) error
+	// ApplyPromoCode проверяет код для заказа пользователя и раскладывает скидку по позициям
+	ApplyPromoCode(code string, userID uuid.UUID, items []model.OrderItem) ([]model.OrderItem, error)
+	Redeem(code string, orderID, userID uuid.UUID) error
+	// Release освобождает применение кода отмененным заказом. Заказ без кода - не ошибка
+	Release(orderID uuid.UUID) error
+}
+
+func NewPromoService(
+	promoRepo model.PromoCodeRepository,
+	redemptionRepo model.PromoRedemptionRepository,
+) PromoService {
+	return &promoService{
+		promoRepository:      promoRepo,
+		redemptionRepository: redemptionRepo,
+	}
+}
+
+type promoService struct {
+	promoRepository      model.PromoCodeRepository
+	redemptionRepository model.PromoRedemptionRepository
+}
+
+func (s *promoService) CreatePromoCode(promo model.PromoCode) error {
+	promo.Code = NormalizePromoCode(promo.Code)
+	err := validatePromoCode(promo)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.promoRepository.Find(promo.Code)
+	if err == nil {
+		return model.ErrPromoCodeAlreadyExists
+	}
+	if !errors.Is(err, model.ErrPromoCodeNotFound) {
+		return err
+	}
+
+	promo.CreatedAt = time.Now()
+	return s.promoRepository.Store(promo)
+}
+
+func (s *promoService) ApplyPromoCode(code string, userID uuid.UUID, items []model.OrderItem) ([]model.OrderItem, error) {
+	promo, err := s.promoRepository.Find(NormalizePromoCode(code))
+	if err != nil {
+		return nil, err
+	}
+
+	if !promo.IsActive(time.Now()) {
+		return nil, model.ErrPromoCodeNotActive
+	}
+
+	var subtotal int64
+	for _, item := range items {
+		subtotal += item.Price * int64(item.Quantity)
+	}
+	if subtotal < promo.MinOrderValue {
+		return nil, model.ErrPromoCodeMinOrderValue
+	}
+
+	if promo.UsageLimit > 0 {
+		used, err := s.redemptionRepository.CountActive(promo.Code, nil)
+		if err != nil {
+			return nil, err
+		}
+		if used >= promo.UsageLimit {
+			return nil, model.ErrPromoCodeUsageLimit
+		}
+	}
+	if promo.PerUserLimit > 0 {
+		used, err := s.redemptionRepository.CountActive(promo.Code, &userID)
+		if err != nil {
+			return nil, err
+		}
+		if used >= promo.PerUserLimit {
+			return nil, model.ErrPromoCodeUserLimit
+		}
+	}
+
+	discount := promo.Value
+	if promo.Type == model.DiscountPercent {
+		discount = subtotal * promo.Value / 100
+	}
+	return allocateDiscount(items, min(discount, subtotal), subtotal), nil
+}
+
+func (s *promoService) Redeem(code string, orderID, userID uuid.UUID) error {
+	return s.redemptionRepository.Store(model.PromoRedemption{
+		Code:       NormalizePromoCode(code),
+		OrderID:    orderID,
+		UserID:     userID,
+		RedeemedAt: time.Now(),
+	})
+}
+
+func (s *promoService) Release(orderID uuid.UUID) error {
+	redemption, err := s.redemptionRepository.FindForOrder(orderID)
+	if errors.Is(err, model.ErrPromoCodeNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if redemption.ReleasedAt != nil {
+		return nil
+	}
+
+	releasedAt := time.Now()
+	redemption.ReleasedAt = &releasedAt
+	return s.redemptionRepository.Store(*redemption)
+}
+
+// NormalizePromoCode приводит код к виду, в котором он хранится: клиент может ввести его в любом регистре
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func validatePromoCode(promo model.PromoCode) error {
+	switch {
+	case promo.Code == "" || len(promo.Code) > model.MaxPromoCodeLength:
+		return model.ErrInvalidPromoCode
+	case promo.Type != model.DiscountPercent && promo.Type != model.DiscountFixed:
+		return model.ErrInvalidPromoCode
+	case promo.Value <= 0 || (promo.Type == model.DiscountPercent && promo.Value > 100):
+		return model.ErrInvalidPromoCode
+	case promo.MinOrderValue < 0 || promo.UsageLimit < 0 || promo.PerUserLimit < 0:
+		return model.ErrInvalidPromoCode
+	case promo.ValidFrom != nil && promo.ValidTo != nil && !promo.ValidFrom.Before(*promo.ValidTo):
+		return model.ErrInvalidPromoCode
+	}
+	return nil
+}
+
+// allocateDiscount раскладывает скидку на заказ по позициям пропорционально их стоимости.
+// Копейки, потерянные при округлении вниз, раздаются по одной позициям, у которых есть запас,
+// так что сумма скидок по позициям равна скидке на заказ и не превышает стоимость позиции
+func allocateDiscount(items []model.OrderItem, discount, subtotal int64) []model.OrderItem {
+	result := make([]model.OrderItem, len(items))
+	if subtotal == 0 {
+		copy(result, items)
+		return result
+	}
+
+	remaining := discount
+	for i, item := range items {
+		result[i] = item
+		result[i].Discount = discount * item.Price * int64(item.Quantity) / subtotal
+		remaining -= result[i].Discount
+	}
+	for i := 0; remaining > 0; i = (i + 1) % len(result) {
+		if result[i].Discount < result[i].Price*int64(result[i].Quantity) {
+			result[i].Discount++
+			remaining--
+		}
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type InMemoryPromoCodeRepository struct {
+	promos map[string]model.PromoCode
+}
+
+func (r *InMemoryPromoCodeRepository) Store(promo model.PromoCode) error {
+	r.promos[promo.Code] = promo
+	return nil
+}
+
+func (r *InMemoryPromoCodeRepository) Find(code string) (*model.PromoCode, error) {
+	promo, ok := r.promos[code]
+	if !ok {
+		return nil, model.ErrPromoCodeNotFound
+	}
+	return &promo, nil
+}
+
+type InMemoryPromoRedemptionRepository struct {
+	redemptions map[uuid.UUID]model.PromoRedemption
+}
+
+func (r *InMemoryPromoRedemptionRepository) Store(redemption model.PromoRedemption) error {
+	r.redemptions[redemption.OrderID] = redemption
+	return nil
+}
+
+func (r *InMemoryPromoRedemptionRepository) FindForOrder(orderID uuid.UUID) (*model.PromoRedemption, error) {
+	redemption, ok := r.redemptions[orderID]
+	if !ok {
+		return nil, model.ErrPromoCodeNotFound
+	}
+	return &redemption, nil
+}
+
+func (r *InMemoryPromoRedemptionRepository) CountActive(code string, userID *uuid.UUID) (int, error) {
+	count := 0
+	for _, redemption := range r.redemptions {
+		if redemption.Code != code || redemption.ReleasedAt != nil {
+			continue
+		}
+		if userID != nil && redemption.UserID != *userID {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+func newPromoService(promos ...model.PromoCode) (PromoService, *InMemoryPromoRedemptionRepository) {
+	promoRepo := &InMemoryPromoCodeRepository{promos: map[string]model.PromoCode{}}
+	for _, promo := range promos {
+		promoRepo.promos[promo.Code] = promo
+	}
+	redemptionRepo := &InMemoryPromoRedemptionRepository{redemptions: map[uuid.UUID]model.PromoRedemption{}}
+	return NewPromoService(promoRepo, redemptionRepo), redemptionRepo
+}
+
+func TestPromoService_ApplyPromoCode(t *testing.T) {
+	userID := uuid.New()
+	items := []model.OrderItem{
+		{ProductID: uuid.New(), Quantity: 1, Price: 100},
+		{ProductID: uuid.New(), Quantity: 2, Price: 100},
+	}
+
+	discounts := func(items []model.OrderItem) []int64 {
+		result := make([]int64, len(items))
+		for i, item := range items {
+			result[i] = item.Discount
+		}
+		return result
+	}
+
+	t.Run("Percent discount is split by line value", func(t *testing.T) {
+		service, _ := newPromoService(model.PromoCode{Code: "SALE10", Type: model.DiscountPercent, Value: 10})
+
+		discounted, err := service.ApplyPromoCode("sale10", userID, items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10, 20}, discounts(discounted))
+	})
+
+	t.Run("Rounding leftovers keep the order discount exact", func(t *testing.T) {
+		service, _ := newPromoService(model.PromoCode{Code: "MINUS100", Type: model.DiscountFixed, Value: 100})
+
+		discounted, err := service.ApplyPromoCode("MINUS100", userID, items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{34, 66}, discounts(discounted))
+	})
+
+	t.Run("Fixed discount is capped by order value", func(t *testing.T) {
+		service, _ := newPromoService(model.PromoCode{Code: "BIG", Type: model.DiscountFixed, Value: 1000})
+
+		discounted, err := service.ApplyPromoCode("BIG", userID, items)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{100, 200}, discounts(discounted))
+	})
+
+	t.Run("Minimum order value", func(t *testing.T) {
+		service, _ := newPromoService(model.PromoCode{Code: "BIG", Type: model.DiscountFixed, Value: 50, MinOrderValue: 301})
+
+		_, err := service.ApplyPromoCode("BIG", userID, items)
+		assert.ErrorIs(t, err, model.ErrPromoCodeMinOrderValue)
+	})
+
+	t.Run("Validity window", func(t *testing.T) {
+		expired := time.Now().Add(-time.Hour)
+		service, _ := newPromoService(model.PromoCode{Code: "OLD", Type: model.DiscountFixed, Value: 50, ValidTo: &expired})
+
+		_, err := service.ApplyPromoCode("OLD", userID, items)
+		assert.ErrorIs(t, err, model.ErrPromoCodeNotActive)
+	})
+
+	t.Run("Usage limits count only active redemptions", func(t *testing.T) {
+		service, _ := newPromoService(model.PromoCode{Code: "ONCE", Type: model.DiscountFixed, Value: 50, UsageLimit: 2, PerUserLimit: 1})
+		orderID := uuid.New()
+
+		assert.NoError(t, service.Redeem("ONCE", orderID, userID))
+		_, err := service.ApplyPromoCode("ONCE", userID, items)
+		assert.ErrorIs(t, err, model.ErrPromoCodeUserLimit)
+
+		assert.NoError(t, service.Redeem("ONCE", uuid.New(), uuid.New()))
+		_, err = service.ApplyPromoCode("ONCE", uuid.New(), items)
+		assert.ErrorIs(t, err, model.ErrPromoCodeUsageLimit)
+
+		// отмена заказа возвращает код в лимиты
+		assert.NoError(t, service.Release(orderID))
+		_, err = service.ApplyPromoCode("ONCE", userID, items)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPromoService_CreatePromoCode(t *testing.T) {
+	service, _ := newPromoService()
+
+	assert.NoError(t, service.CreatePromoCode(model.PromoCode{Code: " welcome ", Type: model.DiscountPercent, Value: 15}))
+	assert.ErrorIs(t, service.CreatePromoCode(model.PromoCode{Code: "WELCOME", Type: model.DiscountFixed, Value: 100}), model.ErrPromoCodeAlreadyExists)
+	assert.ErrorIs(t, service.CreatePromoCode(model.PromoCode{Code: "TOO_MUCH", Type: model.DiscountPercent, Value: 150}), model.ErrInvalidPromoCode)
+
+	// у заказа без промокода освобождать нечего
+	assert.NoError(t, service.Release(uuid.New()))
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type RecurringOrderService interface {
+	Create(userID uuid.UUID, items []model.OrderItem, interval time.Duration) (uuid.UUID, error)
+	Pause(recurringOrderID uuid.UUID) error
+	Resume(recurringOrderID uuid.UUID) error
+	Cancel(recurringOrderID uuid.UUID) error
+	// RecordRun записывает запуск по расписанию: с созданным заказом или с ошибкой, если его создать не удалось
+	RecordRun(recurringOrderID, runID uuid.UUID, orderID *uuid.UUID, failure string) error
+	HandleOrderPaid(orderID uuid.UUID) error
+	HandleOrderCancelled(orderID uuid.UUID, reason string) error
+}
+
+func NewRecurringOrderService(repository model.RecurringOrderRepository) RecurringOrderService {
+	return &recurringOrderService{
+		repository: repository,
+	}
+}
+
+type recurringOrderService struct {
+	repository model.RecurringOrderRepository
+}
+
+func (s *recurringOrderService) Create(userID uuid.UUID, items []model.OrderItem, interval time.Duration) (uuid.UUID, error) {
+	if len(items) == 0 {
+		return uuid.Nil, model.ErrEmptyOrder
+	}
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return uuid.Nil, model.ErrInvalidRecurringItemQuantity
+		}
+	}
+	if interval < model.MinRecurringInterval {
+		return uuid.Nil, model.ErrInvalidRecurringInterval
+	}
+
+	recurringOrderID, err := s.repository.NextID()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	now := time.Now()
+	return recurringOrderID, s.repository.Store(model.RecurringOrder{
+		RecurringOrderID: recurringOrderID,
+		UserID:           userID,
+		Items:            items,
+		Interval:         interval,
+		Status:           model.RecurringActive,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	})
+}
+
+func (s *recurringOrderService) Pause(recurringOrderID uuid.UUID) error {
+	return s.changeStatus(recurringOrderID, model.RecurringActive, model.RecurringPaused)
+}
+
+func (s *recurringOrderService) Resume(recurringOrderID uuid.UUID) error {
+	return s.changeStatus(recurringOrderID, model.RecurringPaused, model.RecurringActive)
+}
+
+func (s *recurringOrderService) Cancel(recurringOrderID uuid.UUID) error {
+	recurringOrder, err := s.repository.Find(recurringOrderID)
+	if err != nil {
+		return err
+	}
+
+	if recurringOrder.Status == model.RecurringCancelled {
+		return nil
+	}
+
+	recurringOrder.Status = model.RecurringCancelled
+	recurringOrder.UpdatedAt = time.Now()
+	return s.repository.Store(*recurringOrder)
+}
+
+func (s *recurringOrderService) RecordRun(recurringOrderID, runID uuid.UUID, orderID *uuid.UUID, failure string) error {
+	now := time.Now()
+	run := model.RecurringOrderRun{
+		RunID:            runID,
+		RecurringOrderID: recurringOrderID,
+		OrderID:          orderID,
+		Status:           model.RunPlaced,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if orderID == nil {
+		run.Status = model.RunFailed
+		run.Error = failure
+	}
+	return s.repository.StoreRun(run)
+}
+
+func (s *recurringOrderService) HandleOrderPaid(orderID uuid.UUID) error {
+	return s.finishRun(orderID, model.RunPaid, "")
+}
+
+// HandleOrderCancelled завершает запуск по отмене заказа. Нехватка денег не ошибка расписания:
+// запуск пропускается, следующий пройдет в свое время
+func (s *recurringOrderService) HandleOrderCancelled(orderID uuid.UUID, reason string) error {
+	status := model.RunFailed
+	if reason == model.CancelReasonInsufficientFunds {
+		status = model.RunSkipped
+	}
+	return s.finishRun(orderID, status, reason)
+}
+
+func (s *recurringOrderService) finishRun(orderID uuid.UUID, status model.RecurringRunStatus, reason string) error {
+	run, err := s.repository.FindRunForOrder(orderID)
+	if errors.Is(err, model.ErrRecurringRunNotFound) {
+		// обычный заказ, не по расписанию
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if run.Status != model.RunPlaced {
+		return nil
+	}
+
+	run.Status = status
+	run.Error = reason
+	run.UpdatedAt = time.Now()
+	return s.repository.StoreRun(*run)
+}
+
+func (s *recurringOrderService) changeStatus(recurringOrderID uuid.UUID, from, to model.RecurringOrderStatus) error {
+	recurringOrder, err := s.repository.Find(recurringOrderID)
+	if err != nil {
+		return err
+	}
+
+	if recurringOrder.Status == to {
+		return nil
+	}
+	if recurringOrder.Status != from {
+		return model.ErrInvalidRecurringOrderStatus
+	}
+
+	recurringOrder.Status = to
+	recurringOrder.UpdatedAt = time.Now()
+	return s.repository.Store(*recurringOrder)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type InMemoryRecurringOrderRepository struct {
+	recurringOrders map[uuid.UUID]model.RecurringOrder
+	runs            map[uuid.UUID]model.RecurringOrderRun
+}
+
+func NewInMemoryRecurringOrderRepository() *InMemoryRecurringOrderRepository {
+	return &InMemoryRecurringOrderRepository{
+		recurringOrders: map[uuid.UUID]model.RecurringOrder{},
+		runs:            map[uuid.UUID]model.RecurringOrderRun{},
+	}
+}
+
+func (r *InMemoryRecurringOrderRepository) NextID() (uuid.UUID, error) {
+	return uuid.NewV7()
+}
+
+func (r *InMemoryRecurringOrderRepository) Store(recurringOrder model.RecurringOrder) error {
+	r.recurringOrders[recurringOrder.RecurringOrderID] = recurringOrder
+	return nil
+}
+
+func (r *InMemoryRecurringOrderRepository) Find(recurringOrderID uuid.UUID) (*model.RecurringOrder, error) {
+	recurringOrder, ok := r.recurringOrders[recurringOrderID]
+	if !ok {
+		return nil, model.ErrRecurringOrderNotFound
+	}
+	return &recurringOrder, nil
+}
+
+func (r *InMemoryRecurringOrderRepository) StoreRun(run model.RecurringOrderRun) error {
+	r.runs[run.RunID] = run
+	return nil
+}
+
+func (r *InMemoryRecurringOrderRepository) FindRunForOrder(orderID uuid.UUID) (*model.RecurringOrderRun, error) {
+	for _, run := range r.runs {
+		if run.OrderID != nil && *run.OrderID == orderID {
+			return &run, nil
+		}
+	}
+	return nil, model.ErrRecurringRunNotFound
+}
+
+func TestRecurringOrderService_Create(t *testing.T) {
+	items := []model.OrderItem{{ProductID: uuid.New(), Quantity: 2}}
+
+	t.Run("Interval shorter than an hour", func(t *testing.T) {
+		service := NewRecurringOrderService(NewInMemoryRecurringOrderRepository())
+		_, err := service.Create(uuid.New(), items, 30*time.Minute)
+		assert.ErrorIs(t, err, model.ErrInvalidRecurringInterval)
+	})
+
+	t.Run("Empty items", func(t *testing.T) {
+		service := NewRecurringOrderService(NewInMemoryRecurringOrderRepository())
+		_, err := service.Create(uuid.New(), nil, 24*time.Hour)
+		assert.ErrorIs(t, err, model.ErrEmptyOrder)
+	})
+
+	t.Run("Created active", func(t *testing.T) {
+		repo := NewInMemoryRecurringOrderRepository()
+		service := NewRecurringOrderService(repo)
+
+		id, err := service.Create(uuid.New(), items, 7*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, model.RecurringActive, repo.recurringOrders[id].Status)
+		assert.Equal(t, items, repo.recurringOrders[id].Items)
+	})
+}
+
+func TestRecurringOrderService_StatusChanges(t *testing.T) {
+	repo := NewInMemoryRecurringOrderRepository()
+	service := NewRecurringOrderService(repo)
+	id, err := service.Create(uuid.New(), []model.OrderItem{{ProductID: uuid.New(), Quantity: 1}}, 24*time.Hour)
+	assert.NoError(t, err)
+
+	assert.NoError(t, service.Pause(id))
+	assert.NoError(t, service.Pause(id))
+	assert.Equal(t, model.RecurringPaused, repo.recurringOrders[id].Status)
+
+	assert.NoError(t, service.Resume(id))
+	assert.Equal(t, model.RecurringActive, repo.recurringOrders[id].Status)
+
+	assert.NoError(t, service.Cancel(id))
+	assert.ErrorIs(t, service.Pause(id), model.ErrInvalidRecurringOrderStatus)
+	assert.ErrorIs(t, service.Resume(id), model.ErrInvalidRecurringOrderStatus)
+}
+
+func TestRecurringOrderService_Runs(t *testing.T) {
+	recurringOrderID := uuid.New()
+
+	placeRun := func(t *testing.T) (RecurringOrderService, *InMemoryRecurringOrderRepository, uuid.UUID, uuid.UUID) {
+		repo := NewInMemoryRecurringOrderRepository()
+		service := NewRecurringOrderService(repo)
+		runID := uuid.New()
+		orderID := uuid.New()
+		assert.NoError(t, service.RecordRun(recurringOrderID, runID, &orderID, ""))
+		assert.Equal(t, model.RunPlaced, repo.runs[runID].Status)
+		return service, repo, runID, orderID
+	}
+
+	t.Run("Paid", func(t *testing.T) {
+		service, repo, runID, orderID := placeRun(t)
+		assert.NoError(t, service.HandleOrderPaid(orderID))
+		assert.Equal(t, model.RunPaid, repo.runs[runID].Status)
+	})
+
+	t.Run("Insufficient funds skips run", func(t *testing.T) {
+		service, repo, runID, orderID := placeRun(t)
+		assert.NoError(t, service.HandleOrderCancelled(orderID, model.CancelReasonInsufficientFunds))
+		assert.Equal(t, model.RunSkipped, repo.runs[runID].Status)
+		assert.Equal(t, model.CancelReasonInsufficientFunds, repo.runs[runID].Error)
+	})
+
+	t.Run("Other cancellation fails run", func(t *testing.T) {
+		service, repo, runID, orderID := placeRun(t)
+		assert.NoError(t, service.HandleOrderCancelled(orderID, "Failed to reserve products"))
+		assert.Equal(t, model.RunFailed, repo.runs[runID].Status)
+	})
+
+	t.Run("Failed placement", func(t *testing.T) {
+		repo := NewInMemoryRecurringOrderRepository()
+		service := NewRecurringOrderService(repo)
+		runID := uuid.New()
+		assert.NoError(t, service.RecordRun(recurringOrderID, runID, nil, "product for order was deleted"))
+		assert.Equal(t, model.RunFailed, repo.runs[runID].Status)
+		assert.Equal(t, "product for order was deleted", repo.runs[runID].Error)
+	})
+
+	t.Run("Regular order is ignored", func(t *testing.T) {
+		service := NewRecurringOrderService(NewInMemoryRecurringOrderRepository())
+		assert.NoError(t, service.HandleOrderPaid(uuid.New()))
+	})
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"github.com/google/uuid"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+// ReportService обновляет агрегаты отчетов по событиям заказа
+type ReportService interface {
+	HandleOrderPaid(event model.OrderPaid) error
+	HandleOrderCancelled(event model.OrderCancelled) error
+	HandleOrderReturned(event model.OrderReturned) error
+}
+
+func NewReportService(
+	orderRepository model.OrderRepository,
+	reportRepository model.ReportRepository,
+) ReportService {
+	return &reportService{
+		orderRepository:  orderRepository,
+		reportRepository: reportRepository,
+	}
+}
+
+type reportService struct {
+	orderRepository  model.OrderRepository
+	reportRepository model.ReportRepository
+}
+
+func (s *reportService) HandleOrderPaid(event model.OrderPaid) error {
+	order, err := s.orderRepository.Find(event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	day := model.ReportDay(event.PaidAt)
+	err = s.reportRepository.AddDailySales(day, model.DailySales{
+		PaidOrders: 1,
+		Revenue:    order.TotalPrice,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.reportRepository.AddProductSales(day, productSales(order.Items, 1))
+	if err != nil {
+		return err
+	}
+
+	return s.reportRepository.AddStatusCount(day, model.StatusPaid, 1)
+}
+
+func (s *reportService) HandleOrderCancelled(event model.OrderCancelled) error {
+	day := model.ReportDay(event.CancelledAt)
+	err := s.reportRepository.AddStatusCount(day, model.StatusCancelled, 1)
+	if err != nil {
+		return err
+	}
+
+	// неоплаченный заказ в выручку не попадал
+	if !event.Refunded {
+		return nil
+	}
+
+	order, err := s.orderRepository.Find(event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	err = s.reportRepository.AddDailySales(day, model.DailySales{
+		RefundedOrders: 1,
+		Refunds:        order.TotalPrice,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.reportRepository.AddProductSales(day, productSales(order.Items, -1))
+}
+
+// HandleOrderReturned учитывает возврат части заказа в день возврата. Заказ при этом не считается возвращенным целиком
+func (s *reportService) HandleOrderReturned(event model.OrderReturned) error {
+	order, err := s.orderRepository.Find(event.OrderID)
+	if err != nil {
+		return err
+	}
+
+	day := model.ReportDay(event.ReturnedAt)
+	err = s.reportRepository.AddDailySales(day, model.DailySales{
+		Refunds: event.RefundAmount,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.reportRepository.AddProductSales(day, returnedSales(order.Items, event.Items))
+}
+
+// productSales считает продажи по позициям заказа, sign = -1 для возврата
+func productSales(items []model.OrderItem, sign int) []model.ProductSales {
+	sales := make([]model.ProductSales, len(items))
+	for i, item := range items {
+		sales[i] = model.ProductSales{
+			ProductID: item.ProductID,
+			Quantity:  sign * item.Quantity,
+			Revenue:   int64(sign) * (item.Price*int64(item.Quantity) - item.Discount),
+		}
+	}
+	return sales
+}
+
+// returnedSales вычитает из продаж возвращенные штуки. Выручка позиции с учетом скидки делится пропорционально количеству
+func returnedSales(orderItems []model.OrderItem, returnItems []model.ReturnItem) []model.ProductSales {
+	ordered := make(map[uuid.UUID]model.OrderItem, len(orderItems))
+	for _, item := range orderItems {
+		ordered[item.ProductID] = item
+	}
+
+	sales := make([]model.ProductSales, 0, len(returnItems))
+	for _, item := range returnItems {
+		orderItem, ok := ordered[item.ProductID]
+		if !ok || orderItem.Quantity == 0 {
+			continue
+		}
+		itemRevenue := orderItem.Price*int64(orderItem.Quantity) - orderItem.Discount
+		sales = append(sales, model.ProductSales{
+			ProductID: item.ProductID,
+			Quantity:  -item.Quantity,
+			Revenue:   -itemRevenue * int64(item.Quantity) / int64(orderItem.Quantity),
+		})
+	}
+	return sales
+}
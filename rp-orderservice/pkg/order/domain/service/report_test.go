@@ -0,0 +1,135 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type InMemoryReportRepository struct {
+	sales    map[time.Time]model.DailySales
+	products map[time.Time]map[uuid.UUID]model.ProductSales
+	statuses map[time.Time]map[model.OrderStatus]int
+}
+
+func NewInMemoryReportRepository() *InMemoryReportRepository {
+	return &InMemoryReportRepository{
+		sales:    map[time.Time]model.DailySales{},
+		products: map[time.Time]map[uuid.UUID]model.ProductSales{},
+		statuses: map[time.Time]map[model.OrderStatus]int{},
+	}
+}
+
+func (r *InMemoryReportRepository) AddDailySales(day time.Time, sales model.DailySales) error {
+	total := r.sales[day]
+	total.PaidOrders += sales.PaidOrders
+	total.Revenue += sales.Revenue
+	total.RefundedOrders += sales.RefundedOrders
+	total.Refunds += sales.Refunds
+	r.sales[day] = total
+	return nil
+}
+
+func (r *InMemoryReportRepository) AddProductSales(day time.Time, sales []model.ProductSales) error {
+	if r.products[day] == nil {
+		r.products[day] = map[uuid.UUID]model.ProductSales{}
+	}
+	for _, s := range sales {
+		total := r.products[day][s.ProductID]
+		total.ProductID = s.ProductID
+		total.Quantity += s.Quantity
+		total.Revenue += s.Revenue
+		r.products[day][s.ProductID] = total
+	}
+	return nil
+}
+
+func (r *InMemoryReportRepository) AddStatusCount(day time.Time, status model.OrderStatus, count int) error {
+	if r.statuses[day] == nil {
+		r.statuses[day] = map[model.OrderStatus]int{}
+	}
+	r.statuses[day][status] += count
+	return nil
+}
+
+func TestReportService(t *testing.T) {
+	orderID := uuid.New()
+	productA := uuid.New()
+	productB := uuid.New()
+	order := &model.Order{
+		OrderID: orderID,
+		Items: []model.OrderItem{
+			{ProductID: productA, Quantity: 2, Price: 300, Discount: 60},
+			{ProductID: productB, Quantity: 1, Price: 400, Discount: 40},
+		},
+		TotalPrice: 900,
+	}
+	paidAt := time.Date(2024, 7, 29, 23, 30, 0, 0, time.UTC)
+	cancelledAt := paidAt.Add(time.Hour)
+	paidDay := time.Date(2024, 7, 29, 0, 0, 0, 0, time.UTC)
+	cancelledDay := time.Date(2024, 7, 30, 0, 0, 0, 0, time.UTC)
+
+	newService := func() (ReportService, *InMemoryReportRepository) {
+		orderRepo := new(MockOrderRepository)
+		orderRepo.On("Find", orderID).Return(order, nil)
+		reportRepo := NewInMemoryReportRepository()
+		return NewReportService(orderRepo, reportRepo), reportRepo
+	}
+
+	t.Run("Paid order adds revenue", func(t *testing.T) {
+		service, reportRepo := newService()
+
+		err := service.HandleOrderPaid(model.OrderPaid{OrderID: orderID, PaidAt: paidAt})
+		assert.NoError(t, err)
+		assert.Equal(t, model.DailySales{PaidOrders: 1, Revenue: 900}, reportRepo.sales[paidDay])
+		assert.Equal(t, model.ProductSales{ProductID: productA, Quantity: 2, Revenue: 540}, reportRepo.products[paidDay][productA])
+		assert.Equal(t, model.ProductSales{ProductID: productB, Quantity: 1, Revenue: 360}, reportRepo.products[paidDay][productB])
+		assert.Equal(t, 1, reportRepo.statuses[paidDay][model.StatusPaid])
+	})
+
+	t.Run("Refund is counted on cancellation day", func(t *testing.T) {
+		service, reportRepo := newService()
+
+		assert.NoError(t, service.HandleOrderPaid(model.OrderPaid{OrderID: orderID, PaidAt: paidAt}))
+		err := service.HandleOrderCancelled(model.OrderCancelled{OrderID: orderID, Refunded: true, CancelledAt: cancelledAt})
+		assert.NoError(t, err)
+
+		assert.Equal(t, model.DailySales{PaidOrders: 1, Revenue: 900}, reportRepo.sales[paidDay])
+		assert.Equal(t, model.DailySales{RefundedOrders: 1, Refunds: 900}, reportRepo.sales[cancelledDay])
+		assert.Equal(t, model.ProductSales{ProductID: productA, Quantity: -2, Revenue: -540}, reportRepo.products[cancelledDay][productA])
+		assert.Equal(t, 1, reportRepo.statuses[cancelledDay][model.StatusCancelled])
+	})
+
+	t.Run("Return is counted on return day", func(t *testing.T) {
+		service, reportRepo := newService()
+
+		assert.NoError(t, service.HandleOrderPaid(model.OrderPaid{OrderID: orderID, PaidAt: paidAt}))
+		err := service.HandleOrderReturned(model.OrderReturned{
+			OrderID:      orderID,
+			Items:        []model.ReturnItem{{ProductID: productA, Quantity: 1}},
+			RefundAmount: 270,
+			ReturnedAt:   cancelledAt,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, model.DailySales{PaidOrders: 1, Revenue: 900}, reportRepo.sales[paidDay])
+		assert.Equal(t, model.DailySales{Refunds: 270}, reportRepo.sales[cancelledDay])
+		assert.Equal(t, model.ProductSales{ProductID: productA, Quantity: -1, Revenue: -270}, reportRepo.products[cancelledDay][productA])
+		assert.NotContains(t, reportRepo.products[cancelledDay], productB)
+		assert.Empty(t, reportRepo.statuses[cancelledDay])
+	})
+
+	t.Run("Unpaid cancellation does not touch revenue", func(t *testing.T) {
+		service, reportRepo := newService()
+
+		err := service.HandleOrderCancelled(model.OrderCancelled{OrderID: orderID, CancelledAt: cancelledAt})
+		assert.NoError(t, err)
+		assert.Empty(t, reportRepo.sales)
+		assert.Empty(t, reportRepo.products)
+		assert.Equal(t, 1, reportRepo.statuses[cancelledDay][model.StatusCancelled])
+	})
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"orderservice/pkg/common/domain"
+	"orderservice/pkg/order/domain/model"
+)
+
+type ReturnService interface {
+	RequestReturn(orderID uuid.UUID, items []model.ReturnItem, reason string) (uuid.UUID, error)
+	Approve(returnID uuid.UUID) error
+	Reject(returnID uuid.UUID, reason string) error
+	// Complete закрывает возврат после того, как товары вернулись на склад, а деньги - клиенту
+	Complete(returnID uuid.UUID) error
+}
+
+func NewReturnService(
+	orderRepo model.OrderRepository,
+	returnRepo model.ReturnRepository,
+	eventDispatcher domain.EventDispatcher,
+) ReturnService {
+	return &returnService{
+		orderRepository:  orderRepo,
+		returnRepository: returnRepo,
+		eventDispatcher:  eventDispatcher,
+	}
+}
+
+type returnService struct {
+	orderRepository  model.OrderRepository
+	returnRepository model.ReturnRepository
+	eventDispatcher  domain.EventDispatcher
+}
+
+func (s *returnService) RequestReturn(orderID uuid.UUID, items []model.ReturnItem, reason string) (uuid.UUID, error) {
+	items, err := mergeReturnItems(items)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	order, err := s.orderRepository.Find(orderID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if order.Status != model.StatusDelivered {
+		return uuid.Nil, model.ErrInvalidStatus
+	}
+
+	returned, refunded, err := s.earlierReturns(orderID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	ordered := make(map[uuid.UUID]model.OrderItem, len(order.Items))
+	var orderValue int64
+	for _, item := range order.Items {
+		ordered[item.ProductID] = item
+		orderValue += item.Price * int64(item.Quantity)
+	}
+
+	var returnedValue int64
+	for productID, quantity := range returned {
+		returnedValue += ordered[productID].Price * int64(quantity)
+	}
+
+	var returnValue int64
+	for _, item := range items {
+		orderItem, ok := ordered[item.ProductID]
+		if !ok {
+			return uuid.Nil, model.ErrReturnItemNotInOrder
+		}
+		if item.Quantity > orderItem.Quantity-returned[item.ProductID] {
+			return uuid.Nil, model.ErrReturnQuantityExceeded
+		}
+		returnValue += orderItem.Price * int64(item.Quantity)
+	}
+
+	returnID, err := s.returnRepository.NextID()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	currentTime := time.Now()
+	return returnID, s.returnRepository.Store(model.Return{
+		ReturnID:     returnID,
+		OrderID:      orderID,
+		UserID:       order.UserID,
+		Items:        items,
+		RefundAmount: refundAmount(order.TotalPrice, returnedValue, returnValue, orderValue, refunded),
+		Status:       model.ReturnRequested,
+		Reason:       reason,
+		CreatedAt:    currentTime,
+		UpdatedAt:    currentTime,
+	})
+}
+
+func (s *returnService) Approve(returnID uuid.UUID) error {
+	orderReturn, err := s.returnRepository.Find(returnID)
+	if err != nil {
+		return err
+	}
+
+	if orderReturn.Status == model.ReturnApproved {
+		return nil
+	}
+	if orderReturn.Status != model.ReturnRequested {
+		return model.ErrInvalidReturnStatus
+	}
+
+	orderReturn.Status = model.ReturnApproved
+	orderReturn.UpdatedAt = time.Now()
+	return s.returnRepository.Store(*orderReturn)
+}
+
+func (s *returnService) Reject(returnID uuid.UUID, reason string) error {
+	orderReturn, err := s.returnRepository.Find(returnID)
+	if err != nil {
+		return err
+	}
+
+	if orderReturn.Status == model.ReturnRejected {
+		return nil
+	}
+	if orderReturn.Status != model.ReturnRequested {
+		return model.ErrInvalidReturnStatus
+	}
+
+	orderReturn.Status = model.ReturnRejected
+	orderReturn.Reason = reason
+	orderReturn.UpdatedAt = time.Now()
+	return s.returnRepository.Store(*orderReturn)
+}
+
+func (s *returnService) Complete(returnID uuid.UUID) error {
+	orderReturn, err := s.returnRepository.Find(returnID)
+	if err != nil {
+		return err
+	}
+
+	if orderReturn.Status == model.ReturnCompleted {
+		return nil
+	}
+	if orderReturn.Status != model.ReturnApproved {
+		return model.ErrInvalidReturnStatus
+	}
+
+	orderReturn.Status = model.ReturnCompleted
+	orderReturn.UpdatedAt = time.Now()
+	err = s.returnRepository.Store(*orderReturn)
+	if err != nil {
+		return err
+	}
+
+	return s.eventDispatcher.Dispatch(&model.OrderReturned{
+		OrderID:      orderReturn.OrderID,
+		ReturnID:     orderReturn.ReturnID,
+		UserID:       orderReturn.UserID,
+		Items:        orderReturn.Items,
+		RefundAmount: orderReturn.RefundAmount,
+		ReturnedAt:   orderReturn.UpdatedAt,
+	})
+}
+
+// earlierReturns считает, сколько каждого товара уже заявлено к возврату и сколько денег за них причитается.
+// Отклоненные заявки не в счет
+func (s *returnService) earlierReturns(orderID uuid.UUID) (map[uuid.UUID]int, int64, error) {
+	returns, err := s.returnRepository.FindForOrder(orderID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	returned := make(map[uuid.UUID]int)
+	var refunded int64
+	for _, orderReturn := range returns {
+		if orderReturn.Status == model.ReturnRejected {
+			continue
+		}
+		for _, item := range orderReturn.Items {
+			returned[item.ProductID] += item.Quantity
+		}
+		refunded += orderReturn.RefundAmount
+	}
+	return returned, refunded, nil
+}
+
+// refundAmount считает возврат нарастающим итогом: доля всех возвращенных позиций минус уже возвращенные деньги.
+// Копейки, потерянные при округлении прошлых возвратов, достаются следующему, а полный возврат вернет ровно оплаченное
+func refundAmount(totalPrice, returnedValue, returnValue, orderValue, refunded int64) int64 {
+	amount := prorate(totalPrice, returnedValue+returnValue, orderValue) - refunded
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
+
+// prorate делит оплаченную сумму заказа пропорционально стоимости позиций.
+// Округляем вниз, чтобы сумма всех возвратов не превысила оплаченное
+func prorate(totalPrice, part, whole int64) int64 {
+	if whole == 0 {
+		return 0
+	}
+	return totalPrice * part / whole
+}
+
+func mergeReturnItems(items []model.ReturnItem) ([]model.ReturnItem, error) {
+	if len(items) == 0 {
+		return nil, model.ErrEmptyReturn
+	}
+
+	merged := make([]model.ReturnItem, 0, len(items))
+	indexes := make(map[uuid.UUID]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, model.ErrInvalidQuantity
+		}
+		if i, ok := indexes[item.ProductID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		indexes[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged, nil
+}
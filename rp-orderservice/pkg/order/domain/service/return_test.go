@@ -0,0 +1,161 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+type InMemoryReturnRepository struct {
+	returns map[uuid.UUID]model.Return
+}
+
+func (r *InMemoryReturnRepository) NextID() (uuid.UUID, error) {
+	return uuid.NewV7()
+}
+
+func (r *InMemoryReturnRepository) Store(orderReturn model.Return) error {
+	r.returns[orderReturn.ReturnID] = orderReturn
+	return nil
+}
+
+func (r *InMemoryReturnRepository) Find(returnID uuid.UUID) (*model.Return, error) {
+	orderReturn, ok := r.returns[returnID]
+	if !ok {
+		return nil, model.ErrReturnNotFound
+	}
+	return &orderReturn, nil
+}
+
+func (r *InMemoryReturnRepository) FindForOrder(orderID uuid.UUID) ([]model.Return, error) {
+	var returns []model.Return
+	for _, orderReturn := range r.returns {
+		if orderReturn.OrderID == orderID {
+			returns = append(returns, orderReturn)
+		}
+	}
+	return returns, nil
+}
+
+func TestReturnService_RequestReturn(t *testing.T) {
+	orderID := uuid.New()
+	productA := uuid.New()
+	productB := uuid.New()
+	// 2*300 + 1*400 = 1000, оплачено 900: возврат считается от оплаченной суммы
+	order := &model.Order{
+		OrderID: orderID,
+		UserID:  uuid.New(),
+		Status:  model.StatusDelivered,
+		Items: []model.OrderItem{
+			{ProductID: productA, Quantity: 2, Price: 300},
+			{ProductID: productB, Quantity: 1, Price: 400},
+		},
+		TotalPrice: 900,
+	}
+
+	newService := func() (ReturnService, *InMemoryReturnRepository) {
+		orderRepo := new(MockOrderRepository)
+		orderRepo.On("Find", orderID).Return(order, nil)
+		returnRepo := &InMemoryReturnRepository{returns: map[uuid.UUID]model.Return{}}
+		return NewReturnService(orderRepo, returnRepo, new(MockEventDispatcher)), returnRepo
+	}
+
+	t.Run("Partial return is pro-rated", func(t *testing.T) {
+		service, returnRepo := newService()
+
+		returnID, err := service.RequestReturn(orderID, []model.ReturnItem{{ProductID: productA, Quantity: 1}}, "broken")
+		assert.NoError(t, err)
+
+		orderReturn := returnRepo.returns[returnID]
+		assert.Equal(t, model.ReturnRequested, orderReturn.Status)
+		assert.Equal(t, int64(270), orderReturn.RefundAmount)
+		assert.Equal(t, order.UserID, orderReturn.UserID)
+	})
+
+	t.Run("Quantity is limited by earlier returns", func(t *testing.T) {
+		service, _ := newService()
+
+		_, err := service.RequestReturn(orderID, []model.ReturnItem{{ProductID: productA, Quantity: 1}}, "")
+		assert.NoError(t, err)
+		_, err = service.RequestReturn(orderID, []model.ReturnItem{{ProductID: productA, Quantity: 1}, {ProductID: productA, Quantity: 1}}, "")
+		assert.ErrorIs(t, err, model.ErrReturnQuantityExceeded)
+	})
+
+	t.Run("Rejected returns free the quantity", func(t *testing.T) {
+		service, _ := newService()
+
+		returnID, err := service.RequestReturn(orderID, []model.ReturnItem{{ProductID: productB, Quantity: 1}}, "")
+		assert.NoError(t, err)
+		assert.NoError(t, service.Reject(returnID, "no defect found"))
+
+		_, err = service.RequestReturn(orderID, []model.ReturnItem{{ProductID: productB, Quantity: 1}}, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Last return gets the rounding remainder", func(t *testing.T) {
+		// 3*100, оплачено 200: каждая штука стоит 66.(6), округление вниз теряет копейки
+		thirdsOrderID := uuid.New()
+		orderRepo := new(MockOrderRepository)
+		orderRepo.On("Find", thirdsOrderID).Return(&model.Order{
+			OrderID:    thirdsOrderID,
+			Status:     model.StatusDelivered,
+			Items:      []model.OrderItem{{ProductID: productA, Quantity: 3, Price: 100}},
+			TotalPrice: 200,
+		}, nil)
+		returnRepo := &InMemoryReturnRepository{returns: map[uuid.UUID]model.Return{}}
+		service := NewReturnService(orderRepo, returnRepo, new(MockEventDispatcher))
+
+		var refunds []int64
+		for i := 0; i < 3; i++ {
+			returnID, err := service.RequestReturn(thirdsOrderID, []model.ReturnItem{{ProductID: productA, Quantity: 1}}, "")
+			assert.NoError(t, err)
+			refunds = append(refunds, returnRepo.returns[returnID].RefundAmount)
+		}
+		assert.Equal(t, []int64{66, 67, 67}, refunds)
+	})
+
+	t.Run("Unknown product", func(t *testing.T) {
+		service, _ := newService()
+
+		_, err := service.RequestReturn(orderID, []model.ReturnItem{{ProductID: uuid.New(), Quantity: 1}}, "")
+		assert.ErrorIs(t, err, model.ErrReturnItemNotInOrder)
+	})
+
+	t.Run("Order not delivered", func(t *testing.T) {
+		paidOrderID := uuid.New()
+		orderRepo := new(MockOrderRepository)
+		orderRepo.On("Find", paidOrderID).Return(&model.Order{OrderID: paidOrderID, Status: model.StatusPaid}, nil)
+		service := NewReturnService(orderRepo, &InMemoryReturnRepository{returns: map[uuid.UUID]model.Return{}}, new(MockEventDispatcher))
+
+		_, err := service.RequestReturn(paidOrderID, []model.ReturnItem{{ProductID: productA, Quantity: 1}}, "")
+		assert.ErrorIs(t, err, model.ErrInvalidStatus)
+	})
+}
+
+func TestReturnService_Complete(t *testing.T) {
+	returnID := uuid.New()
+	returnRepo := &InMemoryReturnRepository{returns: map[uuid.UUID]model.Return{
+		returnID: {ReturnID: returnID, OrderID: uuid.New(), RefundAmount: 270, Status: model.ReturnRequested},
+	}}
+	dispatcher := new(MockEventDispatcher)
+	dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.OrderReturned) bool {
+		return e.ReturnID == returnID && e.RefundAmount == 270
+	})).Return(nil).Once()
+	service := NewReturnService(new(MockOrderRepository), returnRepo, dispatcher)
+
+	// неодобренный возврат завершить нельзя
+	assert.ErrorIs(t, service.Complete(returnID), model.ErrInvalidReturnStatus)
+
+	assert.NoError(t, service.Approve(returnID))
+	assert.NoError(t, service.Complete(returnID))
+	assert.Equal(t, model.ReturnCompleted, returnRepo.returns[returnID].Status)
+
+	// повтор активити не шлет событие второй раз
+	assert.NoError(t, service.Complete(returnID))
+	assert.ErrorIs(t, service.Reject(returnID, ""), model.ErrInvalidReturnStatus)
+	dispatcher.AssertExpectations(t)
+}
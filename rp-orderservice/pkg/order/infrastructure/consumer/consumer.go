@@ -29,12 +29,13 @@ func NewEventConsumer(
 	conn amqp.Connection,
 	pool mysql.ConnectionPool,
 	logger logging.Logger,
+	orderService appservice.OrderService,
 ) (*EventConsumer, error) {
 	uow := &unitOfWorkForSync{pool: pool}
 
 	return &EventConsumer{
 		conn:            conn,
-		dataSyncService: appservice.NewDataSyncService(uow),
+		dataSyncService: appservice.NewDataSyncService(uow, newLockableUnitOfWorkForSync(pool), orderService),
 		logger:          logger,
 		ctx:             ctx,
 		pool:            pool,
@@ -59,9 +60,10 @@ func (c *EventConsumer) handle(ctx context.Context, delivery amqp.Delivery) (err
 	l.Info("processing event")
 
 	switch delivery.Type {
-	case "user_created", "user_updated":
+	case "user_created":
 		var event struct {
 			UserID string `json:"user_id"`
+			Status int    `json:"status"`
 			Login  string `json:"login"`
 		}
 		if err = json.Unmarshal(delivery.Body, &event); err != nil {
@@ -77,6 +79,7 @@ func (c *EventConsumer) handle(ctx context.Context, delivery amqp.Delivery) (err
 		storeErr := c.dataSyncService.SyncUser(ctx, model.LocalUser{
 			UserID: userID,
 			Login:  event.Login,
+			Status: localUserStatus(event.Status),
 		})
 		if storeErr != nil {
 			l.Error(storeErr, "failed to sync user")
@@ -85,11 +88,68 @@ func (c *EventConsumer) handle(ctx context.Context, delivery amqp.Delivery) (err
 		l.Info("user synced successfully")
 		return errors.New("user processed")
 
+	case "user_updated":
+		var event struct {
+			UserID        string `json:"user_id"`
+			UpdatedAt     int64  `json:"updated_at"`
+			UpdatedFields *struct {
+				Status *int `json:"status"`
+			} `json:"updated_fields"`
+		}
+		if err = json.Unmarshal(delivery.Body, &event); err != nil {
+			l.Error(err, "failed to unmarshal user event")
+			return nil
+		}
+		// из изменений пользователя заказам важен только статус
+		if event.UpdatedFields == nil || event.UpdatedFields.Status == nil {
+			return errors.New("user processed")
+		}
+		userID, parseErr := uuid.Parse(event.UserID)
+		if parseErr != nil {
+			l.Error(parseErr, "invalid user id in user event")
+			return nil
+		}
+
+		updateErr := c.dataSyncService.UpdateUserStatus(ctx, userID, localUserStatus(*event.UpdatedFields.Status), time.Unix(event.UpdatedAt, 0))
+		if updateErr != nil {
+			l.Error(updateErr, "failed to update user status")
+			return nil
+		}
+		l.Info("user status updated successfully")
+		return errors.New("user processed")
+
+	case "user_deleted":
+		var event struct {
+			UserID    string `json:"user_id"`
+			DeletedAt int64  `json:"deleted_at"`
+			Hard      bool   `json:"hard"`
+		}
+		if err = json.Unmarshal(delivery.Body, &event); err != nil {
+			l.Error(err, "failed to unmarshal user event")
+			return nil
+		}
+		userID, parseErr := uuid.Parse(event.UserID)
+		if parseErr != nil {
+			l.Error(parseErr, "invalid user id in user event")
+			return nil
+		}
+
+		deleteErr := c.dataSyncService.DeleteUser(ctx, userID, time.Unix(event.DeletedAt, 0), event.Hard)
+		if deleteErr != nil {
+			l.Error(deleteErr, "failed to delete user")
+			return nil
+		}
+		l.Info("user deleted successfully")
+		return errors.New("user processed")
+
 	case "product_created", "product_updated":
+		// оба события несут полный снимок товара, применяем его по версии
 		var event struct {
-			ProductID string `json:"product_id"`
-			Name      string `json:"name"`
-			Price     int64  `json:"price"`
+			ProductID   string  `json:"product_id"`
+			Name        string  `json:"name"`
+			Description *string `json:"description"`
+			Price       int64   `json:"price"`
+			Version     int64   `json:"version"`
 		}
 		if err = json.Unmarshal(delivery.Body, &event); err != nil {
 			l.Error(err, "failed to unmarshal product event")
@@ -103,9 +163,11 @@ func (c *EventConsumer) handle(ctx context.Context, delivery amqp.Delivery) (err
 		}
 
 		storeErr := c.dataSyncService.SyncProduct(ctx, model.LocalProduct{
-			ProductID: productID,
-			Name:      event.Name,
-			Price:     event.Price,
+			ProductID:   productID,
+			Name:        event.Name,
+			Description: event.Description,
+			Price:       event.Price,
+			Version:     event.Version,
 		})
 		if storeErr != nil {
 			l.Error(storeErr, "failed to sync product")
@@ -114,8 +176,45 @@ func (c *EventConsumer) handle(ctx context.Context, delivery amqp.Delivery) (err
 		l.Info("product synced successfully")
 		return errors.New("product processed")
 
+	case "product_deleted":
+		var event struct {
+			ProductID string `json:"product_id"`
+			Version   int64  `json:"version"`
+			DeletedAt int64  `json:"deleted_at"`
+		}
+		if err = json.Unmarshal(delivery.Body, &event); err != nil {
+			l.Error(err, "failed to unmarshal product event")
+			return nil
+		}
+
+		productID, parseErr := uuid.Parse(event.ProductID)
+		if parseErr != nil {
+			l.Error(parseErr, "invalid product id in product event")
+			return nil
+		}
+
+		deleteErr := c.dataSyncService.DeleteProduct(ctx, productID, event.Version, time.Unix(event.DeletedAt, 0))
+		if deleteErr != nil {
+			l.Error(deleteErr, "failed to delete product")
+			return nil
+		}
+		l.Info("product deleted successfully")
+		return errors.New("product processed")
+
 	default:
+		// прочие события (например, stock_changed) не несут данных для проекций.
+		// Их подтверждаем, иначе они бесконечно возвращались бы в очередь
 		l.WithField("type", delivery.Type).Info("unhandled event type")
-		return nil
+		return errors.New("event skipped")
+	}
+}
+
+// userStatusBlocked - код статуса Blocked в событиях userservice
+const userStatusBlocked = 0
+
+func localUserStatus(status int) model.LocalUserStatus {
+	if status == userStatusBlocked {
+		return model.LocalUserBlocked
 	}
+	return model.LocalUserActive
 }
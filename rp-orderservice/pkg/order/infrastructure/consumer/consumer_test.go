@@ -0,0 +1,25 @@
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/amqp"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventConsumer_AcksUnhandledEvents(t *testing.T) {
+	c, err := NewEventConsumer(context.Background(), nil, nil, logging.NewJSONLogger(&logging.Config{}), nil)
+	assert.NoError(t, err)
+
+	// consumer подтверждает сообщение, только если обработчик вернул ошибку
+	for _, eventType := range []string{"stock_changed", "unknown"} {
+		err = c.Handler()(context.Background(), amqp.Delivery{
+			RoutingKey: "product." + eventType,
+			Type:       eventType,
+			Body:       []byte(`{"product_id":"6a2f41a3-c54c-fce8-32d2-0324e1c32e22"}`),
+		})
+		assert.Error(t, err, eventType)
+	}
+}
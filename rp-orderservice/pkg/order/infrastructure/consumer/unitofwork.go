@@ -17,3 +17,9 @@ func (u *unitOfWorkForSync) Execute(ctx context.Context, f func(provider service
 	uow := mysql.NewUnitOfWork(u.pool, inframysql.NewRepositoryProvider)
 	return uow.ExecuteWithRepositoryProvider(ctx, f)
 }
+
+// newLockableUnitOfWorkForSync - локи те же, что у orderService: блокировка пользователя ждет создания его заказа
+func newLockableUnitOfWorkForSync(pool mysql.ConnectionPool) service.LockableUnitOfWork {
+	uow := mysql.NewUnitOfWork(pool, inframysql.NewRepositoryProvider)
+	return inframysql.NewLockableUnitOfWork(mysql.NewLockableUnitOfWork(uow, mysql.NewLocker(pool)))
+}
@@ -25,12 +25,15 @@ func (s eventSerializer) Serialize(event outbox.Event) (string, error) {
 				ProductID: item.ProductID.String(),
 				Quantity:  item.Quantity,
 				Price:     item.Price,
+				Discount:  item.Discount,
 			}
 		}
 		b, err := json.Marshal(OrderCreated{
 			OrderID:    e.OrderID.String(),
 			UserID:     e.UserID.String(),
 			TotalPrice: e.TotalPrice,
+			Discount:   e.Discount,
+			PromoCode:  e.PromoCode,
 			Items:      items,
 			CreatedAt:  e.CreatedAt.Unix(),
 		})
@@ -51,6 +54,42 @@ func (s eventSerializer) Serialize(event outbox.Event) (string, error) {
 		})
 		return string(b), errors.WithStack(err)
 
+	case *model.OrderShipped:
+		b, err := json.Marshal(OrderShipped{
+			OrderID:        e.OrderID.String(),
+			UserID:         e.UserID.String(),
+			Carrier:        e.Carrier,
+			TrackingNumber: e.TrackingNumber,
+			ShippedAt:      e.ShippedAt.Unix(),
+		})
+		return string(b), errors.WithStack(err)
+
+	case *model.OrderDelivered:
+		b, err := json.Marshal(OrderDelivered{
+			OrderID:     e.OrderID.String(),
+			UserID:      e.UserID.String(),
+			DeliveredAt: e.DeliveredAt.Unix(),
+		})
+		return string(b), errors.WithStack(err)
+
+	case *model.OrderReturned:
+		items := make([]ReturnItem, len(e.Items))
+		for i, item := range e.Items {
+			items[i] = ReturnItem{
+				ProductID: item.ProductID.String(),
+				Quantity:  item.Quantity,
+			}
+		}
+		b, err := json.Marshal(OrderReturned{
+			OrderID:      e.OrderID.String(),
+			ReturnID:     e.ReturnID.String(),
+			UserID:       e.UserID.String(),
+			Items:        items,
+			RefundAmount: e.RefundAmount,
+			ReturnedAt:   e.ReturnedAt.Unix(),
+		})
+		return string(b), errors.WithStack(err)
+
 	default:
 		return "", errors.Errorf("unknown event %q", event.Type())
 	}
@@ -60,12 +99,15 @@ type OrderItem struct {
 	ProductID string `json:"product_id"`
 	Quantity  int    `json:"quantity"`
 	Price     int64  `json:"price"`
+	Discount  int64  `json:"discount"`
 }
 
 type OrderCreated struct {
 	OrderID    string      `json:"order_id"`
 	UserID     string      `json:"user_id"`
 	TotalPrice int64       `json:"total_price"`
+	Discount   int64       `json:"discount"`
+	PromoCode  string      `json:"promo_code,omitempty"`
 	Items      []OrderItem `json:"items"`
 	CreatedAt  int64       `json:"created_at"`
 }
@@ -80,3 +122,31 @@ type OrderCancelled struct {
 	Reason      string `json:"reason"`
 	CancelledAt int64  `json:"cancelled_at"`
 }
+
+type OrderShipped struct {
+	OrderID        string `json:"order_id"`
+	UserID         string `json:"user_id"`
+	Carrier        string `json:"carrier"`
+	TrackingNumber string `json:"tracking_number"`
+	ShippedAt      int64  `json:"shipped_at"`
+}
+
+type OrderDelivered struct {
+	OrderID     string `json:"order_id"`
+	UserID      string `json:"user_id"`
+	DeliveredAt int64  `json:"delivered_at"`
+}
+
+type ReturnItem struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+type OrderReturned struct {
+	OrderID      string       `json:"order_id"`
+	ReturnID     string       `json:"return_id"`
+	UserID       string       `json:"user_id"`
+	Items        []ReturnItem `json:"items"`
+	RefundAmount int64        `json:"refund_amount"`
+	ReturnedAt   int64        `json:"returned_at"`
+}
@@ -46,4 +46,16 @@ var builderFunctions = []MigrationBuilderFunc{
 	NewVersion1722266006,
 	NewVersion1722266007,
 	NewVersion1722266008,
+	NewVersion1722266013,
+	NewVersion1722266016,
+	NewVersion1722266017,
+	NewVersion1722266018,
+	NewVersion1722266019,
+	NewVersion1722266020,
+	NewVersion1722266023,
+	NewVersion1722266024,
+	NewVersion1722266025,
+	NewVersion1722266026,
+	NewVersion1722266027,
+	NewVersion1722266029,
 }
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266013(client mysql.ClientContext) migrator.Migration {
+	return &version1722266013{
+		client: client,
+	}
+}
+
+type version1722266013 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266013) Version() int64 {
+	return 1722266013
+}
+
+func (v version1722266013) Description() string {
+	return "Create 'order_idempotency_key' table"
+}
+
+func (v version1722266013) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE order_idempotency_key
+		(
+			user_id         VARCHAR(64)  NOT NULL,
+			idempotency_key VARCHAR(128) NOT NULL,
+			request_hash    VARCHAR(64)  NOT NULL,
+			order_id        VARCHAR(64)  NOT NULL,
+			created_at      DATETIME     NOT NULL,
+			PRIMARY KEY (user_id, idempotency_key)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	return errors.WithStack(err)
+}
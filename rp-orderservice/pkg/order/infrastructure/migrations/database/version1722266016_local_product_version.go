@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266016(client mysql.ClientContext) migrator.Migration {
+	return &version1722266016{
+		client: client,
+	}
+}
+
+type version1722266016 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266016) Version() int64 {
+	return 1722266016
+}
+
+func (v version1722266016) Description() string {
+	return "Add 'description' and 'version' to 'local_product'"
+}
+
+func (v version1722266016) Up(ctx context.Context) error {
+	// строки, записанные до версионирования, получают версию 0 - любой снимок их перепишет
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE local_product
+		    ADD COLUMN description TEXT NULL AFTER name,
+		    ADD COLUMN version BIGINT NOT NULL DEFAULT 0 AFTER price
+	`)
+	return errors.WithStack(err)
+}
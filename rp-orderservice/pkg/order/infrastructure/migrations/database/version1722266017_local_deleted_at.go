@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266017(client mysql.ClientContext) migrator.Migration {
+	return &version1722266017{
+		client: client,
+	}
+}
+
+type version1722266017 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266017) Version() int64 {
+	return 1722266017
+}
+
+func (v version1722266017) Description() string {
+	return "Add 'deleted_at' to 'local_product' and 'local_user'"
+}
+
+func (v version1722266017) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE local_product
+		    ADD COLUMN deleted_at DATETIME NULL
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		ALTER TABLE local_user
+		    ADD COLUMN deleted_at DATETIME NULL
+	`)
+	return errors.WithStack(err)
+}
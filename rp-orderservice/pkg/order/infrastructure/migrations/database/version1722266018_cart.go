@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266018(client mysql.ClientContext) migrator.Migration {
+	return &version1722266018{
+		client: client,
+	}
+}
+
+type version1722266018 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266018) Version() int64 {
+	return 1722266018
+}
+
+func (v version1722266018) Description() string {
+	return "Create 'cart' and 'cart_item' tables"
+}
+
+func (v version1722266018) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE cart
+		(
+			user_id    VARCHAR(64) NOT NULL,
+			updated_at DATETIME    NOT NULL,
+			PRIMARY KEY (user_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE cart_item
+		(
+			user_id    VARCHAR(64) NOT NULL,
+			product_id VARCHAR(64) NOT NULL,
+			quantity   INT         NOT NULL,
+			position   INT         NOT NULL,
+			PRIMARY KEY (user_id, product_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	return errors.WithStack(err)
+}
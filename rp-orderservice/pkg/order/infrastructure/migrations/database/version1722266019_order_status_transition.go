@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266019(client mysql.ClientContext) migrator.Migration {
+	return &version1722266019{
+		client: client,
+	}
+}
+
+type version1722266019 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266019) Version() int64 {
+	return 1722266019
+}
+
+func (v version1722266019) Description() string {
+	return "Create 'order_status_transition' table"
+}
+
+func (v version1722266019) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE order_status_transition
+		(
+			transition_id VARCHAR(64)  NOT NULL,
+			order_id      VARCHAR(64)  NOT NULL,
+			from_status   INT          NULL,
+			to_status     INT          NOT NULL,
+			reason        TEXT         NOT NULL,
+			actor         VARCHAR(32)  NOT NULL,
+			occurred_at   DATETIME     NOT NULL,
+			PRIMARY KEY (transition_id),
+			INDEX order_status_transition_order_id_idx (order_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	return errors.WithStack(err)
+}
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266020(client mysql.ClientContext) migrator.Migration {
+	return &version1722266020{
+		client: client,
+	}
+}
+
+type version1722266020 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266020) Version() int64 {
+	return 1722266020
+}
+
+func (v version1722266020) Description() string {
+	return "Add shipment columns to 'order'"
+}
+
+func (v version1722266020) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE `+"`order`"+`
+		    ADD COLUMN carrier VARCHAR(255) NULL AFTER status,
+		    ADD COLUMN tracking_number VARCHAR(255) NULL AFTER carrier
+	`)
+	return errors.WithStack(err)
+}
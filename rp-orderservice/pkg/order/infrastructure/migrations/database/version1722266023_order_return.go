@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266023(client mysql.ClientContext) migrator.Migration {
+	return &version1722266023{
+		client: client,
+	}
+}
+
+type version1722266023 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266023) Version() int64 {
+	return 1722266023
+}
+
+func (v version1722266023) Description() string {
+	return "Create 'order_return' and 'order_return_item' tables"
+}
+
+func (v version1722266023) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE order_return
+		(
+			return_id     VARCHAR(64) NOT NULL,
+			order_id      VARCHAR(64) NOT NULL,
+			user_id       VARCHAR(64) NOT NULL,
+			refund_amount BIGINT      NOT NULL,
+			status        INT         NOT NULL,
+			reason        TEXT        NOT NULL,
+			created_at    DATETIME    NOT NULL,
+			updated_at    DATETIME    NOT NULL,
+			PRIMARY KEY (return_id),
+			INDEX order_return_order_id_idx (order_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE order_return_item
+		(
+			return_id  VARCHAR(64) NOT NULL,
+			product_id VARCHAR(64) NOT NULL,
+			quantity   INT         NOT NULL,
+			position   INT         NOT NULL,
+			PRIMARY KEY (return_id, product_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	return errors.WithStack(err)
+}
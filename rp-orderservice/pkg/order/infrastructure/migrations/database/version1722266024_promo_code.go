@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266024(client mysql.ClientContext) migrator.Migration {
+	return &version1722266024{
+		client: client,
+	}
+}
+
+type version1722266024 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266024) Version() int64 {
+	return 1722266024
+}
+
+func (v version1722266024) Description() string {
+	return "Create 'promo_code' and 'promo_redemption' tables, add discount columns to orders"
+}
+
+func (v version1722266024) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE promo_code
+		(
+			code            VARCHAR(64) NOT NULL,
+			discount_type   INT         NOT NULL,
+			value           BIGINT      NOT NULL,
+			min_order_value BIGINT      NOT NULL,
+			usage_limit     INT         NOT NULL,
+			per_user_limit  INT         NOT NULL,
+			valid_from      DATETIME    NULL,
+			valid_to        DATETIME    NULL,
+			created_at      DATETIME    NOT NULL,
+			PRIMARY KEY (code)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE promo_redemption
+		(
+			order_id    VARCHAR(64) NOT NULL,
+			code        VARCHAR(64) NOT NULL,
+			user_id     VARCHAR(64) NOT NULL,
+			redeemed_at DATETIME    NOT NULL,
+			released_at DATETIME    NULL,
+			PRIMARY KEY (order_id),
+			INDEX promo_redemption_code_user_id_idx (code, user_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		ALTER TABLE `+"`order`"+`
+		    ADD COLUMN discount BIGINT NOT NULL DEFAULT 0 AFTER total_price,
+		    ADD COLUMN promo_code VARCHAR(64) NULL AFTER discount
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		ALTER TABLE order_item
+		    ADD COLUMN discount BIGINT NOT NULL DEFAULT 0 AFTER price
+	`)
+	return errors.WithStack(err)
+}
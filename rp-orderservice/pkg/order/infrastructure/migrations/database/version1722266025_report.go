@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266025(client mysql.ClientContext) migrator.Migration {
+	return &version1722266025{
+		client: client,
+	}
+}
+
+type version1722266025 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266025) Version() int64 {
+	return 1722266025
+}
+
+func (v version1722266025) Description() string {
+	return "Create report aggregate tables"
+}
+
+func (v version1722266025) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE report_daily_sales
+		(
+			day             DATE   NOT NULL,
+			paid_orders     INT    NOT NULL DEFAULT 0,
+			revenue         BIGINT NOT NULL DEFAULT 0,
+			refunded_orders INT    NOT NULL DEFAULT 0,
+			refunds         BIGINT NOT NULL DEFAULT 0,
+			PRIMARY KEY (day)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE report_daily_product
+		(
+			day        DATE        NOT NULL,
+			product_id VARCHAR(64) NOT NULL,
+			quantity   INT         NOT NULL DEFAULT 0,
+			revenue    BIGINT      NOT NULL DEFAULT 0,
+			PRIMARY KEY (day, product_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE report_daily_status
+		(
+			day    DATE NOT NULL,
+			status INT  NOT NULL,
+			orders INT  NOT NULL DEFAULT 0,
+			PRIMARY KEY (day, status)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	return errors.WithStack(err)
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266026(client mysql.ClientContext) migrator.Migration {
+	return &version1722266026{
+		client: client,
+	}
+}
+
+type version1722266026 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266026) Version() int64 {
+	return 1722266026
+}
+
+func (v version1722266026) Description() string {
+	return "Create 'recurring_order', 'recurring_order_item' and 'recurring_order_run' tables"
+}
+
+func (v version1722266026) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE recurring_order
+		(
+			recurring_order_id VARCHAR(64) NOT NULL,
+			user_id            VARCHAR(64) NOT NULL,
+			interval_seconds   BIGINT      NOT NULL,
+			status             INT         NOT NULL,
+			created_at         DATETIME    NOT NULL,
+			updated_at         DATETIME    NOT NULL,
+			PRIMARY KEY (recurring_order_id),
+			INDEX recurring_order_user_id_idx (user_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE recurring_order_item
+		(
+			recurring_order_id VARCHAR(64) NOT NULL,
+			product_id         VARCHAR(64) NOT NULL,
+			quantity           INT         NOT NULL,
+			position           INT         NOT NULL,
+			PRIMARY KEY (recurring_order_id, product_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE recurring_order_run
+		(
+			run_id             VARCHAR(64) NOT NULL,
+			recurring_order_id VARCHAR(64) NOT NULL,
+			order_id           VARCHAR(64) NULL,
+			status             INT         NOT NULL,
+			error              TEXT        NOT NULL,
+			created_at         DATETIME    NOT NULL,
+			updated_at         DATETIME    NOT NULL,
+			PRIMARY KEY (run_id),
+			INDEX recurring_order_run_recurring_order_id_idx (recurring_order_id),
+			UNIQUE INDEX recurring_order_run_order_id_idx (order_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci;
+	`)
+	return errors.WithStack(err)
+}
@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266027(client mysql.ClientContext) migrator.Migration {
+	return &version1722266027{
+		client: client,
+	}
+}
+
+type version1722266027 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266027) Version() int64 {
+	return 1722266027
+}
+
+func (v version1722266027) Description() string {
+	return "Add 'status' to 'local_user'"
+}
+
+func (v version1722266027) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE local_user
+		    ADD COLUMN status TINYINT NOT NULL DEFAULT 0
+	`)
+	return errors.WithStack(err)
+}
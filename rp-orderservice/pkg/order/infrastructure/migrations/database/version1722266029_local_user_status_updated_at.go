@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266029(client mysql.ClientContext) migrator.Migration {
+	return &version1722266029{
+		client: client,
+	}
+}
+
+type version1722266029 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266029) Version() int64 {
+	return 1722266029
+}
+
+func (v version1722266029) Description() string {
+	return "Add 'status_updated_at' to 'local_user'"
+}
+
+func (v version1722266029) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE local_user
+		    ADD COLUMN status_updated_at DATETIME NULL AFTER status
+	`)
+	return errors.WithStack(err)
+}
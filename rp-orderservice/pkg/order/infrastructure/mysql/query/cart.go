@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/application/query"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewCartQueryService(client mysql.ClientContext) query.CartQueryService {
+	return &cartQueryService{
+		client: client,
+	}
+}
+
+type cartQueryService struct {
+	client mysql.ClientContext
+}
+
+func (s *cartQueryService) GetCart(ctx context.Context, userID uuid.UUID) (_ appmodel.Cart, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_query", "cart", status).Observe(time.Since(start).Seconds())
+	}()
+
+	cart := appmodel.Cart{UserID: userID}
+
+	var updatedAt time.Time
+	err = s.client.GetContext(ctx, &updatedAt, `SELECT updated_at FROM cart WHERE user_id = ?`, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return cart, nil
+		}
+		return appmodel.Cart{}, errors.WithStack(err)
+	}
+	cart.UpdatedAt = updatedAt.Unix()
+
+	// цены берем из local_product в момент чтения, в корзине они не хранятся
+	var itemsData []struct {
+		ProductID uuid.UUID      `db:"product_id"`
+		Quantity  int            `db:"quantity"`
+		Name      sql.NullString `db:"name"`
+		Price     sql.NullInt64  `db:"price"`
+		DeletedAt sql.NullTime   `db:"deleted_at"`
+	}
+	err = s.client.SelectContext(ctx, &itemsData, `
+		SELECT ci.product_id, ci.quantity, lp.name, lp.price, lp.deleted_at
+		FROM cart_item ci
+		LEFT JOIN local_product lp ON lp.product_id = ci.product_id
+		WHERE ci.user_id = ?
+		ORDER BY ci.position`,
+		userID,
+	)
+	if err != nil {
+		return appmodel.Cart{}, errors.WithStack(err)
+	}
+
+	cart.Items = make([]appmodel.CartItem, len(itemsData))
+	for i, itemData := range itemsData {
+		item := appmodel.CartItem{
+			ProductID: itemData.ProductID,
+			Name:      itemData.Name.String,
+			Quantity:  itemData.Quantity,
+			Price:     itemData.Price.Int64,
+			Available: itemData.Price.Valid && !itemData.DeletedAt.Valid,
+		}
+		if item.Available {
+			cart.TotalPrice += item.Price * int64(item.Quantity)
+		}
+		cart.Items[i] = item
+	}
+	return cart, nil
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
@@ -36,14 +37,18 @@ func (s *orderQueryService) FindOrder(ctx context.Context, orderID uuid.UUID) (_
 	}()
 
 	orderData := struct {
-		OrderID    uuid.UUID `db:"order_id"`
-		UserID     uuid.UUID `db:"user_id"`
-		TotalPrice int64     `db:"total_price"`
-		Status     int       `db:"status"`
-		CreatedAt  time.Time `db:"created_at"`
+		OrderID        uuid.UUID      `db:"order_id"`
+		UserID         uuid.UUID      `db:"user_id"`
+		TotalPrice     int64          `db:"total_price"`
+		Discount       int64          `db:"discount"`
+		PromoCode      sql.NullString `db:"promo_code"`
+		Status         int            `db:"status"`
+		Carrier        sql.NullString `db:"carrier"`
+		TrackingNumber sql.NullString `db:"tracking_number"`
+		CreatedAt      time.Time      `db:"created_at"`
 	}{}
 
-	err = s.client.GetContext(ctx, &orderData, "SELECT order_id, user_id, total_price, status, created_at FROM `order` WHERE order_id = ?", orderID)
+	err = s.client.GetContext(ctx, &orderData, "SELECT order_id, user_id, total_price, discount, promo_code, status, carrier, tracking_number, created_at FROM `order` WHERE order_id = ?", orderID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(model.ErrOrderNotFound)
@@ -68,12 +73,210 @@ func (s *orderQueryService) FindOrder(ctx context.Context, orderID uuid.UUID) (_
 		}
 	}
 
+	history, err := s.findHistory(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &appmodel.Order{
-		OrderID:    orderData.OrderID,
-		UserID:     orderData.UserID,
-		Items:      items,
-		TotalPrice: orderData.TotalPrice,
-		Status:     orderData.Status,
-		CreatedAt:  orderData.CreatedAt.Unix(),
+		OrderID:        orderData.OrderID,
+		UserID:         orderData.UserID,
+		Items:          items,
+		TotalPrice:     orderData.TotalPrice,
+		Discount:       orderData.Discount,
+		PromoCode:      orderData.PromoCode.String,
+		Status:         orderData.Status,
+		Carrier:        orderData.Carrier.String,
+		TrackingNumber: orderData.TrackingNumber.String,
+		CreatedAt:      orderData.CreatedAt.Unix(),
+		History:        history,
 	}, nil
 }
+
+func (s *orderQueryService) findHistory(ctx context.Context, orderID uuid.UUID) ([]appmodel.StatusTransition, error) {
+	var transitionsData []struct {
+		FromStatus sql.NullInt64 `db:"from_status"`
+		ToStatus   int           `db:"to_status"`
+		Reason     string        `db:"reason"`
+		Actor      string        `db:"actor"`
+		OccurredAt time.Time     `db:"occurred_at"`
+	}
+	err := s.client.SelectContext(ctx, &transitionsData,
+		`SELECT from_status, to_status, reason, actor, occurred_at FROM order_status_transition WHERE order_id = ? ORDER BY transition_id`,
+		orderID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	history := make([]appmodel.StatusTransition, len(transitionsData))
+	for i, transitionData := range transitionsData {
+		history[i] = appmodel.StatusTransition{
+			To:         transitionData.ToStatus,
+			Reason:     transitionData.Reason,
+			Actor:      transitionData.Actor,
+			OccurredAt: transitionData.OccurredAt.Unix(),
+		}
+		if transitionData.FromStatus.Valid {
+			from := int(transitionData.FromStatus.Int64)
+			history[i].From = &from
+		}
+	}
+	return history, nil
+}
+
+const (
+	defaultOrdersPageSize = 20
+	maxOrdersPageSize     = 100
+)
+
+func (s *orderQueryService) ListOrders(ctx context.Context, spec appmodel.ListOrders) (_ appmodel.OrdersPage, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("list_query", "order", status).Observe(time.Since(start).Seconds())
+	}()
+
+	pageSize := spec.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultOrdersPageSize
+	}
+	if pageSize > maxOrdersPageSize {
+		pageSize = maxOrdersPageSize
+	}
+
+	// order_id - UUIDv7, поэтому сортировка по нему совпадает с порядком создания и годится как курсор
+	conditions, args := buildListOrdersConditions(spec)
+	query := "SELECT order_id, user_id, total_price, discount, promo_code, status, carrier, tracking_number, created_at FROM `order`"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY order_id DESC LIMIT ?"
+	args = append(args, pageSize+1)
+
+	var ordersData []struct {
+		OrderID        uuid.UUID      `db:"order_id"`
+		UserID         uuid.UUID      `db:"user_id"`
+		TotalPrice     int64          `db:"total_price"`
+		Discount       int64          `db:"discount"`
+		PromoCode      sql.NullString `db:"promo_code"`
+		Status         int            `db:"status"`
+		Carrier        sql.NullString `db:"carrier"`
+		TrackingNumber sql.NullString `db:"tracking_number"`
+		CreatedAt      time.Time      `db:"created_at"`
+	}
+	err = s.client.SelectContext(ctx, &ordersData, query, args...)
+	if err != nil {
+		return appmodel.OrdersPage{}, errors.WithStack(err)
+	}
+
+	var page appmodel.OrdersPage
+	if len(ordersData) > pageSize {
+		ordersData = ordersData[:pageSize]
+		nextAfterID := ordersData[pageSize-1].OrderID
+		page.NextAfterID = &nextAfterID
+	}
+
+	orderIDs := make([]uuid.UUID, len(ordersData))
+	for i, orderData := range ordersData {
+		orderIDs[i] = orderData.OrderID
+	}
+	items, err := s.findItems(ctx, orderIDs)
+	if err != nil {
+		return appmodel.OrdersPage{}, err
+	}
+
+	page.Orders = make([]appmodel.Order, len(ordersData))
+	for i, orderData := range ordersData {
+		page.Orders[i] = appmodel.Order{
+			OrderID:        orderData.OrderID,
+			UserID:         orderData.UserID,
+			Items:          items[orderData.OrderID],
+			TotalPrice:     orderData.TotalPrice,
+			Discount:       orderData.Discount,
+			PromoCode:      orderData.PromoCode.String,
+			Status:         orderData.Status,
+			Carrier:        orderData.Carrier.String,
+			TrackingNumber: orderData.TrackingNumber.String,
+			CreatedAt:      orderData.CreatedAt.Unix(),
+		}
+	}
+	return page, nil
+}
+
+// findItems загружает позиции сразу для всех заказов страницы одним запросом
+func (s *orderQueryService) findItems(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID][]appmodel.OrderItem, error) {
+	items := make(map[uuid.UUID][]appmodel.OrderItem, len(orderIDs))
+	if len(orderIDs) == 0 {
+		return items, nil
+	}
+
+	args := make([]interface{}, len(orderIDs))
+	for i, orderID := range orderIDs {
+		args[i] = orderID
+	}
+
+	var itemsData []struct {
+		OrderID   uuid.UUID `db:"order_id"`
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+	}
+	err := s.client.SelectContext(
+		ctx,
+		&itemsData,
+		`SELECT order_id, product_id, quantity FROM order_item WHERE order_id IN (`+placeholders(len(orderIDs))+`)`,
+		args...,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, itemData := range itemsData {
+		items[itemData.OrderID] = append(items[itemData.OrderID], appmodel.OrderItem{
+			ProductID: itemData.ProductID,
+			Quantity:  itemData.Quantity,
+		})
+	}
+	return items, nil
+}
+
+func buildListOrdersConditions(spec appmodel.ListOrders) (conditions []string, args []interface{}) {
+	if spec.UserID != nil {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, *spec.UserID)
+	}
+	if len(spec.Statuses) > 0 {
+		conditions = append(conditions, "status IN ("+placeholders(len(spec.Statuses))+")")
+		for _, status := range spec.Statuses {
+			args = append(args, status)
+		}
+	}
+	if spec.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *spec.CreatedFrom)
+	}
+	if spec.CreatedTo != nil {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, *spec.CreatedTo)
+	}
+	if spec.MinTotalPrice != nil {
+		conditions = append(conditions, "total_price >= ?")
+		args = append(args, *spec.MinTotalPrice)
+	}
+	if spec.MaxTotalPrice != nil {
+		conditions = append(conditions, "total_price <= ?")
+		args = append(args, *spec.MaxTotalPrice)
+	}
+	if spec.AfterID != nil {
+		conditions = append(conditions, "order_id < ?")
+		args = append(args, *spec.AfterID)
+	}
+	return conditions, args
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
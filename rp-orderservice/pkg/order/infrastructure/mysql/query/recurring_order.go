@@ -0,0 +1,134 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/application/query"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+// recurringOrderRunsLimit - сколько последних запусков отдается вместе с регулярным заказом
+const recurringOrderRunsLimit = 20
+
+func NewRecurringOrderQueryService(client mysql.ClientContext) query.RecurringOrderQueryService {
+	return &recurringOrderQueryService{
+		client: client,
+	}
+}
+
+type recurringOrderQueryService struct {
+	client mysql.ClientContext
+}
+
+func (s *recurringOrderQueryService) FindRecurringOrder(ctx context.Context, recurringOrderID uuid.UUID) (_ *appmodel.RecurringOrder, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrRecurringOrderNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_query", "recurring_order", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var recurringOrderData struct {
+		RecurringOrderID uuid.UUID `db:"recurring_order_id"`
+		UserID           uuid.UUID `db:"user_id"`
+		IntervalSeconds  int64     `db:"interval_seconds"`
+		Status           int       `db:"status"`
+		CreatedAt        time.Time `db:"created_at"`
+		UpdatedAt        time.Time `db:"updated_at"`
+	}
+	err = s.client.GetContext(ctx, &recurringOrderData,
+		`SELECT recurring_order_id, user_id, interval_seconds, status, created_at, updated_at FROM recurring_order WHERE recurring_order_id = ?`,
+		recurringOrderID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrRecurringOrderNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	var itemsData []struct {
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+	}
+	err = s.client.SelectContext(ctx, &itemsData,
+		`SELECT product_id, quantity FROM recurring_order_item WHERE recurring_order_id = ? ORDER BY position`,
+		recurringOrderID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]appmodel.OrderItem, len(itemsData))
+	for i, itemData := range itemsData {
+		items[i] = appmodel.OrderItem{
+			ProductID: itemData.ProductID,
+			Quantity:  itemData.Quantity,
+		}
+	}
+
+	// run_id - UUIDv7, по нему запуски идут в порядке расписания
+	var runsData []struct {
+		RunID     uuid.UUID           `db:"run_id"`
+		OrderID   sql.Null[uuid.UUID] `db:"order_id"`
+		Status    int                 `db:"status"`
+		Error     string              `db:"error"`
+		CreatedAt time.Time           `db:"created_at"`
+		UpdatedAt time.Time           `db:"updated_at"`
+	}
+	err = s.client.SelectContext(ctx, &runsData,
+		`SELECT run_id, order_id, status, error, created_at, updated_at FROM recurring_order_run
+		 WHERE recurring_order_id = ? ORDER BY run_id DESC LIMIT ?`,
+		recurringOrderID, recurringOrderRunsLimit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var lastFailure string
+	err = s.client.GetContext(ctx, &lastFailure,
+		`SELECT error FROM recurring_order_run
+		 WHERE recurring_order_id = ? AND status IN (?, ?) ORDER BY run_id DESC LIMIT 1`,
+		recurringOrderID, model.RunSkipped, model.RunFailed,
+	)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(err)
+	}
+
+	runs := make([]appmodel.RecurringOrderRun, len(runsData))
+	for i, runData := range runsData {
+		runs[i] = appmodel.RecurringOrderRun{
+			RunID:     runData.RunID,
+			Status:    runData.Status,
+			Error:     runData.Error,
+			CreatedAt: runData.CreatedAt.Unix(),
+			UpdatedAt: runData.UpdatedAt.Unix(),
+		}
+		if runData.OrderID.Valid {
+			orderID := runData.OrderID.V
+			runs[i].OrderID = &orderID
+		}
+	}
+
+	return &appmodel.RecurringOrder{
+		RecurringOrderID: recurringOrderData.RecurringOrderID,
+		UserID:           recurringOrderData.UserID,
+		Items:            items,
+		Interval:         recurringOrderData.IntervalSeconds,
+		Status:           recurringOrderData.Status,
+		LastFailure:      lastFailure,
+		CreatedAt:        recurringOrderData.CreatedAt.Unix(),
+		UpdatedAt:        recurringOrderData.UpdatedAt.Unix(),
+		Runs:             runs,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/application/query"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+const (
+	defaultTopProductsLimit = 10
+	maxTopProductsLimit     = 100
+)
+
+func NewReportingQueryService(client mysql.ClientContext) query.ReportingQueryService {
+	return &reportingQueryService{
+		client: client,
+	}
+}
+
+type reportingQueryService struct {
+	client mysql.ClientContext
+}
+
+func (s *reportingQueryService) Revenue(ctx context.Context, report appmodel.RevenueReport) (_ []appmodel.RevenuePoint, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("revenue_query", "report_daily_sales", status).Observe(time.Since(start).Seconds())
+	}()
+
+	// неделя начинается с понедельника: WEEKDAY у понедельника 0
+	period := "day"
+	if report.Granularity == appmodel.GranularityWeek {
+		period = "DATE_SUB(day, INTERVAL WEEKDAY(day) DAY)"
+	}
+
+	var rows []struct {
+		PeriodStart    time.Time `db:"period_start"`
+		PaidOrders     int       `db:"paid_orders"`
+		Revenue        int64     `db:"revenue"`
+		RefundedOrders int       `db:"refunded_orders"`
+		Refunds        int64     `db:"refunds"`
+	}
+	err = s.client.SelectContext(ctx, &rows,
+		`SELECT `+period+` AS period_start,
+			SUM(paid_orders) AS paid_orders, SUM(revenue) AS revenue,
+			SUM(refunded_orders) AS refunded_orders, SUM(refunds) AS refunds
+		 FROM report_daily_sales
+		 WHERE day >= ? AND day <= ?
+		 GROUP BY period_start
+		 ORDER BY period_start`,
+		model.ReportDay(report.Period.From), model.ReportDay(report.Period.To),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	points := make([]appmodel.RevenuePoint, len(rows))
+	for i, row := range rows {
+		points[i] = appmodel.RevenuePoint{
+			PeriodStart:    row.PeriodStart.Unix(),
+			PaidOrders:     row.PaidOrders,
+			Revenue:        row.Revenue,
+			RefundedOrders: row.RefundedOrders,
+			Refunds:        row.Refunds,
+		}
+	}
+	return points, nil
+}
+
+func (s *reportingQueryService) TopProducts(ctx context.Context, report appmodel.TopProductsReport) (_ []appmodel.ProductSales, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("top_products_query", "report_daily_product", status).Observe(time.Since(start).Seconds())
+	}()
+
+	limit := report.Limit
+	if limit <= 0 {
+		limit = defaultTopProductsLimit
+	}
+	if limit > maxTopProductsLimit {
+		limit = maxTopProductsLimit
+	}
+
+	orderBy := "quantity DESC, revenue DESC"
+	if report.OrderBy == appmodel.OrderByRevenue {
+		orderBy = "revenue DESC, quantity DESC"
+	}
+
+	var rows []struct {
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+		Revenue   int64     `db:"revenue"`
+	}
+	err = s.client.SelectContext(ctx, &rows,
+		`SELECT product_id, SUM(quantity) AS quantity, SUM(revenue) AS revenue
+		 FROM report_daily_product
+		 WHERE day >= ? AND day <= ?
+		 GROUP BY product_id
+		 ORDER BY `+orderBy+`, product_id
+		 LIMIT ?`,
+		model.ReportDay(report.Period.From), model.ReportDay(report.Period.To), limit,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	products := make([]appmodel.ProductSales, len(rows))
+	for i, row := range rows {
+		products[i] = appmodel.ProductSales{
+			ProductID: row.ProductID,
+			Quantity:  row.Quantity,
+			Revenue:   row.Revenue,
+		}
+	}
+	return products, nil
+}
+
+func (s *reportingQueryService) OrderStatusCounts(ctx context.Context, period appmodel.ReportPeriod) (_ []appmodel.StatusCount, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("status_counts_query", "report_daily_status", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var rows []struct {
+		Status int `db:"status"`
+		Orders int `db:"orders"`
+	}
+	err = s.client.SelectContext(ctx, &rows,
+		`SELECT status, SUM(orders) AS orders
+		 FROM report_daily_status
+		 WHERE day >= ? AND day <= ?
+		 GROUP BY status
+		 ORDER BY status`,
+		model.ReportDay(period.From), model.ReportDay(period.To),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	counts := make([]appmodel.StatusCount, len(rows))
+	for i, row := range rows {
+		counts[i] = appmodel.StatusCount{
+			Status: row.Status,
+			Orders: row.Orders,
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,90 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/application/query"
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewReturnQueryService(client mysql.ClientContext) query.ReturnQueryService {
+	return &returnQueryService{
+		client: client,
+	}
+}
+
+type returnQueryService struct {
+	client mysql.ClientContext
+}
+
+func (s *returnQueryService) FindReturn(ctx context.Context, returnID uuid.UUID) (_ *appmodel.Return, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrReturnNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_query", "order_return", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var returnData struct {
+		ReturnID     uuid.UUID `db:"return_id"`
+		OrderID      uuid.UUID `db:"order_id"`
+		UserID       uuid.UUID `db:"user_id"`
+		RefundAmount int64     `db:"refund_amount"`
+		Status       int       `db:"status"`
+		Reason       string    `db:"reason"`
+		CreatedAt    time.Time `db:"created_at"`
+		UpdatedAt    time.Time `db:"updated_at"`
+	}
+	err = s.client.GetContext(ctx, &returnData,
+		`SELECT return_id, order_id, user_id, refund_amount, status, reason, created_at, updated_at FROM order_return WHERE return_id = ?`,
+		returnID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrReturnNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	var itemsData []struct {
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+	}
+	err = s.client.SelectContext(ctx, &itemsData,
+		`SELECT product_id, quantity FROM order_return_item WHERE return_id = ? ORDER BY position`,
+		returnID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]appmodel.OrderItem, len(itemsData))
+	for i, itemData := range itemsData {
+		items[i] = appmodel.OrderItem{
+			ProductID: itemData.ProductID,
+			Quantity:  itemData.Quantity,
+		}
+	}
+
+	return &appmodel.Return{
+		ReturnID:     returnData.ReturnID,
+		OrderID:      returnData.OrderID,
+		UserID:       returnData.UserID,
+		Items:        items,
+		RefundAmount: returnData.RefundAmount,
+		Status:       returnData.Status,
+		Reason:       returnData.Reason,
+		CreatedAt:    returnData.CreatedAt.Unix(),
+		UpdatedAt:    returnData.UpdatedAt.Unix(),
+	}, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewCartRepository(ctx context.Context, client mysql.ClientContext) model.CartRepository {
+	return &cartRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type cartRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *cartRepository) Store(cart model.Cart) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "cart", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO cart (user_id, updated_at) VALUES (?, ?) ON DUPLICATE KEY UPDATE updated_at=VALUES(updated_at)`,
+		cart.UserID, cart.UpdatedAt,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = r.client.ExecContext(r.ctx, `DELETE FROM cart_item WHERE user_id = ?`, cart.UserID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for position, item := range cart.Items {
+		_, err = r.client.ExecContext(r.ctx,
+			`INSERT INTO cart_item (user_id, product_id, quantity, position) VALUES (?, ?, ?, ?)`,
+			cart.UserID, item.ProductID, item.Quantity, position,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *cartRepository) Find(userID uuid.UUID) (_ *model.Cart, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrCartNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "cart", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var updatedAt time.Time
+	err = r.client.GetContext(r.ctx, &updatedAt, `SELECT updated_at FROM cart WHERE user_id = ?`, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrCartNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	var itemsData []struct {
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+	}
+	err = r.client.SelectContext(r.ctx, &itemsData,
+		`SELECT product_id, quantity FROM cart_item WHERE user_id = ? ORDER BY position`,
+		userID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]model.CartItem, len(itemsData))
+	for i, itemData := range itemsData {
+		items[i] = model.CartItem{
+			ProductID: itemData.ProductID,
+			Quantity:  itemData.Quantity,
+		}
+	}
+
+	return &model.Cart{
+		UserID:    userID,
+		Items:     items,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+func (r *cartRepository) Delete(userID uuid.UUID) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("delete", "cart", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx, `DELETE FROM cart_item WHERE user_id = ?`, userID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = r.client.ExecContext(r.ctx, `DELETE FROM cart WHERE user_id = ?`, userID)
+	return errors.WithStack(err)
+}
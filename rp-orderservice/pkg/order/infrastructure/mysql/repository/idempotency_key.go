@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewIdempotencyKeyRepository(ctx context.Context, client mysql.ClientContext) model.IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type idempotencyKeyRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *idempotencyKeyRepository) Store(key model.IdempotencyKey) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "order_idempotency_key", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO order_idempotency_key (user_id, idempotency_key, request_hash, order_id, created_at) VALUES (?, ?, ?, ?, ?)`,
+		key.UserID, key.Key, key.RequestHash, key.OrderID, key.CreatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *idempotencyKeyRepository) Find(userID uuid.UUID, key string) (_ *model.IdempotencyKey, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrIdempotencyKeyNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "order_idempotency_key", status).Observe(time.Since(start).Seconds())
+	}()
+
+	keyData := struct {
+		UserID      uuid.UUID `db:"user_id"`
+		Key         string    `db:"idempotency_key"`
+		RequestHash string    `db:"request_hash"`
+		OrderID     uuid.UUID `db:"order_id"`
+		CreatedAt   time.Time `db:"created_at"`
+	}{}
+
+	err = r.client.GetContext(r.ctx, &keyData,
+		`SELECT user_id, idempotency_key, request_hash, order_id, created_at FROM order_idempotency_key WHERE user_id = ? AND idempotency_key = ?`,
+		userID, key,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrIdempotencyKeyNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.IdempotencyKey{
+		UserID:      keyData.UserID,
+		Key:         keyData.Key,
+		RequestHash: keyData.RequestHash,
+		OrderID:     keyData.OrderID,
+		CreatedAt:   keyData.CreatedAt,
+	}, nil
+}
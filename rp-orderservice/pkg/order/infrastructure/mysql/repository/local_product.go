@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
 	"github.com/google/uuid"
@@ -24,17 +25,23 @@ type localProductRepository struct {
 }
 
 func (r *localProductRepository) Store(product model.LocalProduct) error {
+	// события могут прийти не по порядку или повторно: version обновляется последним,
+	// чтобы остальные колонки сравнивались еще со старой версией
 	_, err := r.client.ExecContext(r.ctx,
-		`INSERT INTO local_product (product_id, name, price) VALUES (?, ?, ?) 
-		 ON DUPLICATE KEY UPDATE name=VALUES(name), price=VALUES(price)`,
-		product.ProductID, product.Name, product.Price,
+		`INSERT INTO local_product (product_id, name, description, price, version) VALUES (?, ?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE
+			name=IF(VALUES(version) > version, VALUES(name), name),
+			description=IF(VALUES(version) > version, VALUES(description), description),
+			price=IF(VALUES(version) > version, VALUES(price), price),
+			version=GREATEST(VALUES(version), version)`,
+		product.ProductID, product.Name, toSQLNull(product.Description), product.Price, product.Version,
 	)
 	return errors.WithStack(err)
 }
 
 func (r *localProductRepository) Find(productID uuid.UUID) (*model.LocalProduct, error) {
 	var product sqlxProduct
-	err := r.client.GetContext(r.ctx, &product, `SELECT product_id, name, price FROM local_product WHERE product_id = ?`, productID)
+	err := r.client.GetContext(r.ctx, &product, `SELECT product_id, name, description, price, version, deleted_at FROM local_product WHERE product_id = ?`, productID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(model.ErrProductNotFound)
@@ -42,9 +49,12 @@ func (r *localProductRepository) Find(productID uuid.UUID) (*model.LocalProduct,
 		return nil, errors.WithStack(err)
 	}
 	return &model.LocalProduct{
-		ProductID: product.ProductID,
-		Name:      product.Name,
-		Price:     product.Price,
+		ProductID:   product.ProductID,
+		Name:        product.Name,
+		Description: fromSQLNull(product.Description),
+		Price:       product.Price,
+		Version:     product.Version,
+		DeletedAt:   fromSQLNull(product.DeletedAt),
 	}, nil
 }
 
@@ -58,7 +68,7 @@ func (r *localProductRepository) FindMany(productIDs []uuid.UUID) ([]model.Local
 	for _, productID := range productIDs {
 		var product sqlxProduct
 		err := r.client.GetContext(r.ctx, &product,
-			`SELECT product_id, name, price FROM local_product WHERE product_id = ?`,
+			`SELECT product_id, name, description, price, version, deleted_at FROM local_product WHERE product_id = ?`,
 			productID)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -67,17 +77,51 @@ func (r *localProductRepository) FindMany(productIDs []uuid.UUID) ([]model.Local
 			return nil, errors.WithStack(err)
 		}
 		products = append(products, model.LocalProduct{
-			ProductID: product.ProductID,
-			Name:      product.Name,
-			Price:     product.Price,
+			ProductID:   product.ProductID,
+			Name:        product.Name,
+			Description: fromSQLNull(product.Description),
+			Price:       product.Price,
+			Version:     product.Version,
+			DeletedAt:   fromSQLNull(product.DeletedAt),
 		})
 	}
 
 	return products, nil
 }
 
+func (r *localProductRepository) MarkDeleted(productID uuid.UUID, version int64, deletedAt time.Time) error {
+	_, err := r.client.ExecContext(r.ctx,
+		`INSERT INTO local_product (product_id, name, price, version, deleted_at) VALUES (?, '', 0, ?, ?)
+		 ON DUPLICATE KEY UPDATE
+			deleted_at=IF(VALUES(version) > version, VALUES(deleted_at), deleted_at),
+			version=GREATEST(VALUES(version), version)`,
+		productID, version, deletedAt,
+	)
+	return errors.WithStack(err)
+}
+
 type sqlxProduct struct {
-	ProductID uuid.UUID `db:"product_id"`
-	Name      string    `db:"name"`
-	Price     int64     `db:"price"`
+	ProductID   uuid.UUID           `db:"product_id"`
+	Name        string              `db:"name"`
+	Description sql.Null[string]    `db:"description"`
+	Price       int64               `db:"price"`
+	Version     int64               `db:"version"`
+	DeletedAt   sql.Null[time.Time] `db:"deleted_at"`
+}
+
+func fromSQLNull[T any](v sql.Null[T]) *T {
+	if v.Valid {
+		return &v.V
+	}
+	return nil
+}
+
+func toSQLNull[T any](v *T) sql.Null[T] {
+	if v == nil {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{
+		V:     *v,
+		Valid: true,
+	}
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
 	"github.com/google/uuid"
@@ -23,17 +24,19 @@ type localUserRepository struct {
 	client mysql.ClientContext
 }
 
+// Store не перетирает статус уже известного пользователя: его меняет только UpdateStatus
 func (r *localUserRepository) Store(user model.LocalUser) error {
 	_, err := r.client.ExecContext(r.ctx,
-		`INSERT INTO local_user (user_id, login) VALUES (?, ?) ON DUPLICATE KEY UPDATE login=VALUES(login)`,
-		user.UserID, user.Login,
+		`INSERT INTO local_user (user_id, login, status) VALUES (?, ?, ?)
+		 ON DUPLICATE KEY UPDATE login=IF(deleted_at IS NULL, VALUES(login), login)`,
+		user.UserID, user.Login, user.Status,
 	)
 	return errors.WithStack(err)
 }
 
 func (r *localUserRepository) Find(userID uuid.UUID) (*model.LocalUser, error) {
 	var user sqlxLocalUser
-	err := r.client.GetContext(r.ctx, &user, `SELECT user_id, login FROM local_user WHERE user_id = ?`, userID)
+	err := r.client.GetContext(r.ctx, &user, `SELECT user_id, login, status, deleted_at FROM local_user WHERE user_id = ?`, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(model.ErrUserNotFound)
@@ -41,12 +44,43 @@ func (r *localUserRepository) Find(userID uuid.UUID) (*model.LocalUser, error) {
 		return nil, errors.WithStack(err)
 	}
 	return &model.LocalUser{
-		UserID: user.UserID,
-		Login:  user.Login,
+		UserID:    user.UserID,
+		Login:     user.Login,
+		Status:    model.LocalUserStatus(user.Status),
+		DeletedAt: fromSQLNull(user.DeletedAt),
 	}, nil
 }
 
+func (r *localUserRepository) MarkDeleted(userID uuid.UUID, deletedAt time.Time) error {
+	// user_deleted может обогнать user_created, поэтому запись создается, если ее еще нет
+	_, err := r.client.ExecContext(r.ctx,
+		`INSERT INTO local_user (user_id, login, deleted_at) VALUES (?, '', ?)
+		 ON DUPLICATE KEY UPDATE deleted_at=IFNULL(deleted_at, VALUES(deleted_at))`,
+		userID, deletedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *localUserRepository) UpdateStatus(userID uuid.UUID, status model.LocalUserStatus, updatedAt time.Time) error {
+	// status обновляется первым, поэтому оба условия сравнивают с еще не измененным status_updated_at
+	_, err := r.client.ExecContext(r.ctx,
+		`INSERT INTO local_user (user_id, login, status, status_updated_at) VALUES (?, '', ?, ?)
+		 ON DUPLICATE KEY UPDATE
+			status=IF(status_updated_at IS NULL OR VALUES(status_updated_at) >= status_updated_at, VALUES(status), status),
+			status_updated_at=IF(status_updated_at IS NULL OR VALUES(status_updated_at) >= status_updated_at, VALUES(status_updated_at), status_updated_at)`,
+		userID, status, updatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *localUserRepository) Anonymize(userID uuid.UUID) error {
+	_, err := r.client.ExecContext(r.ctx, `UPDATE local_user SET login = '' WHERE user_id = ?`, userID)
+	return errors.WithStack(err)
+}
+
 type sqlxLocalUser struct {
-	UserID uuid.UUID `db:"user_id"`
-	Login  string    `db:"login"`
+	UserID    uuid.UUID           `db:"user_id"`
+	Login     string              `db:"login"`
+	Status    int                 `db:"status"`
+	DeletedAt sql.Null[time.Time] `db:"deleted_at"`
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
@@ -39,10 +40,22 @@ func (r *orderRepository) Store(order model.Order) (err error) {
 		metrics.DatabaseDuration.WithLabelValues("store", "order", status).Observe(time.Since(start).Seconds())
 	}()
 
+	var carrier, trackingNumber *string
+	if order.Shipment != nil {
+		carrier = &order.Shipment.Carrier
+		trackingNumber = &order.Shipment.TrackingNumber
+	}
+
+	var promoCode *string
+	if order.PromoCode != "" {
+		promoCode = &order.PromoCode
+	}
+
 	_, err = r.client.ExecContext(r.ctx,
-		"INSERT INTO `order` (order_id, user_id, total_price, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?) "+
-			"ON DUPLICATE KEY UPDATE total_price=VALUES(total_price), status=VALUES(status), updated_at=VALUES(updated_at)",
-		order.OrderID, order.UserID, order.TotalPrice, order.Status, order.CreatedAt, order.UpdatedAt,
+		"INSERT INTO `order` (order_id, user_id, total_price, discount, promo_code, status, carrier, tracking_number, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE total_price=VALUES(total_price), discount=VALUES(discount), promo_code=VALUES(promo_code), status=VALUES(status), "+
+			"carrier=VALUES(carrier), tracking_number=VALUES(tracking_number), updated_at=VALUES(updated_at)",
+		order.OrderID, order.UserID, order.TotalPrice, order.Discount, promoCode, order.Status, carrier, trackingNumber, order.CreatedAt, order.UpdatedAt,
 	)
 	if err != nil {
 		return errors.WithStack(err)
@@ -55,8 +68,8 @@ func (r *orderRepository) Store(order model.Order) (err error) {
 
 	for _, item := range order.Items {
 		_, err = r.client.ExecContext(r.ctx,
-			`INSERT INTO order_item (order_id, product_id, quantity, price) VALUES (?, ?, ?, ?)`,
-			order.OrderID, item.ProductID, item.Quantity, item.Price,
+			`INSERT INTO order_item (order_id, product_id, quantity, price, discount) VALUES (?, ?, ?, ?, ?)`,
+			order.OrderID, item.ProductID, item.Quantity, item.Price, item.Discount,
 		)
 		if err != nil {
 			return errors.WithStack(err)
@@ -77,15 +90,22 @@ func (r *orderRepository) Find(orderID uuid.UUID) (_ *model.Order, err error) {
 	}()
 
 	orderData := struct {
-		OrderID    uuid.UUID `db:"order_id"`
-		UserID     uuid.UUID `db:"user_id"`
-		TotalPrice int64     `db:"total_price"`
-		Status     int       `db:"status"`
-		CreatedAt  time.Time `db:"created_at"`
-		UpdatedAt  time.Time `db:"updated_at"`
+		OrderID        uuid.UUID      `db:"order_id"`
+		UserID         uuid.UUID      `db:"user_id"`
+		TotalPrice     int64          `db:"total_price"`
+		Discount       int64          `db:"discount"`
+		PromoCode      sql.NullString `db:"promo_code"`
+		Status         int            `db:"status"`
+		Carrier        sql.NullString `db:"carrier"`
+		TrackingNumber sql.NullString `db:"tracking_number"`
+		CreatedAt      time.Time      `db:"created_at"`
+		UpdatedAt      time.Time      `db:"updated_at"`
 	}{}
 
-	err = r.client.GetContext(r.ctx, &orderData, "SELECT order_id, user_id, total_price, status, created_at, updated_at FROM `order` WHERE order_id = ?", orderID)
+	err = r.client.GetContext(r.ctx, &orderData,
+		"SELECT order_id, user_id, total_price, discount, promo_code, status, carrier, tracking_number, created_at, updated_at FROM `order` WHERE order_id = ?",
+		orderID,
+	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(model.ErrOrderNotFound)
@@ -97,8 +117,9 @@ func (r *orderRepository) Find(orderID uuid.UUID) (_ *model.Order, err error) {
 		ProductID uuid.UUID `db:"product_id"`
 		Quantity  int       `db:"quantity"`
 		Price     int64     `db:"price"`
+		Discount  int64     `db:"discount"`
 	}
-	err = r.client.SelectContext(r.ctx, &itemsData, `SELECT product_id, quantity, price FROM order_item WHERE order_id = ?`, orderID)
+	err = r.client.SelectContext(r.ctx, &itemsData, `SELECT product_id, quantity, price, discount FROM order_item WHERE order_id = ?`, orderID)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -109,6 +130,15 @@ func (r *orderRepository) Find(orderID uuid.UUID) (_ *model.Order, err error) {
 			ProductID: itemData.ProductID,
 			Quantity:  itemData.Quantity,
 			Price:     itemData.Price,
+			Discount:  itemData.Discount,
+		}
+	}
+
+	var shipment *model.Shipment
+	if orderData.Carrier.Valid {
+		shipment = &model.Shipment{
+			Carrier:        orderData.Carrier.String,
+			TrackingNumber: orderData.TrackingNumber.String,
 		}
 	}
 
@@ -117,8 +147,39 @@ func (r *orderRepository) Find(orderID uuid.UUID) (_ *model.Order, err error) {
 		UserID:     orderData.UserID,
 		Items:      items,
 		TotalPrice: orderData.TotalPrice,
+		Discount:   orderData.Discount,
+		PromoCode:  orderData.PromoCode.String,
 		Status:     model.OrderStatus(orderData.Status),
+		Shipment:   shipment,
 		CreatedAt:  orderData.CreatedAt,
 		UpdatedAt:  orderData.UpdatedAt,
 	}, nil
 }
+
+func (r *orderRepository) FindIDsByUser(userID uuid.UUID, statuses []model.OrderStatus) (_ []uuid.UUID, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_ids_by_user", "order", status).Observe(time.Since(start).Seconds())
+	}()
+
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(statuses)+1)
+	args = append(args, userID)
+	for _, orderStatus := range statuses {
+		args = append(args, orderStatus)
+	}
+
+	var orderIDs []uuid.UUID
+	err = r.client.SelectContext(r.ctx, &orderIDs,
+		"SELECT order_id FROM `order` WHERE user_id = ? AND status IN (?"+strings.Repeat(", ?", len(statuses)-1)+") ORDER BY order_id",
+		args...,
+	)
+	return orderIDs, errors.WithStack(err)
+}
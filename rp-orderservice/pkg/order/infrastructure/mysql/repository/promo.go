@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewPromoCodeRepository(ctx context.Context, client mysql.ClientContext) model.PromoCodeRepository {
+	return &promoCodeRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type promoCodeRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *promoCodeRepository) Store(promo model.PromoCode) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "promo_code", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO promo_code (code, discount_type, value, min_order_value, usage_limit, per_user_limit, valid_from, valid_to, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE discount_type=VALUES(discount_type), value=VALUES(value), min_order_value=VALUES(min_order_value),
+			usage_limit=VALUES(usage_limit), per_user_limit=VALUES(per_user_limit), valid_from=VALUES(valid_from), valid_to=VALUES(valid_to)`,
+		promo.Code, promo.Type, promo.Value, promo.MinOrderValue, promo.UsageLimit, promo.PerUserLimit,
+		promo.ValidFrom, promo.ValidTo, promo.CreatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *promoCodeRepository) Find(code string) (_ *model.PromoCode, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrPromoCodeNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "promo_code", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var promoData struct {
+		Code          string              `db:"code"`
+		DiscountType  int                 `db:"discount_type"`
+		Value         int64               `db:"value"`
+		MinOrderValue int64               `db:"min_order_value"`
+		UsageLimit    int                 `db:"usage_limit"`
+		PerUserLimit  int                 `db:"per_user_limit"`
+		ValidFrom     sql.Null[time.Time] `db:"valid_from"`
+		ValidTo       sql.Null[time.Time] `db:"valid_to"`
+		CreatedAt     time.Time           `db:"created_at"`
+	}
+	err = r.client.GetContext(r.ctx, &promoData,
+		`SELECT code, discount_type, value, min_order_value, usage_limit, per_user_limit, valid_from, valid_to, created_at FROM promo_code WHERE code = ?`,
+		code,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrPromoCodeNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.PromoCode{
+		Code:          promoData.Code,
+		Type:          model.DiscountType(promoData.DiscountType),
+		Value:         promoData.Value,
+		MinOrderValue: promoData.MinOrderValue,
+		UsageLimit:    promoData.UsageLimit,
+		PerUserLimit:  promoData.PerUserLimit,
+		ValidFrom:     fromSQLNull(promoData.ValidFrom),
+		ValidTo:       fromSQLNull(promoData.ValidTo),
+		CreatedAt:     promoData.CreatedAt,
+	}, nil
+}
+
+func NewPromoRedemptionRepository(ctx context.Context, client mysql.ClientContext) model.PromoRedemptionRepository {
+	return &promoRedemptionRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type promoRedemptionRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *promoRedemptionRepository) Store(redemption model.PromoRedemption) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "promo_redemption", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO promo_redemption (order_id, code, user_id, redeemed_at, released_at) VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE released_at=VALUES(released_at)`,
+		redemption.OrderID, redemption.Code, redemption.UserID, redemption.RedeemedAt, redemption.ReleasedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *promoRedemptionRepository) FindForOrder(orderID uuid.UUID) (_ *model.PromoRedemption, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrPromoCodeNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_for_order", "promo_redemption", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var redemptionData struct {
+		OrderID    uuid.UUID           `db:"order_id"`
+		Code       string              `db:"code"`
+		UserID     uuid.UUID           `db:"user_id"`
+		RedeemedAt time.Time           `db:"redeemed_at"`
+		ReleasedAt sql.Null[time.Time] `db:"released_at"`
+	}
+	err = r.client.GetContext(r.ctx, &redemptionData,
+		`SELECT order_id, code, user_id, redeemed_at, released_at FROM promo_redemption WHERE order_id = ?`,
+		orderID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrPromoCodeNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.PromoRedemption{
+		Code:       redemptionData.Code,
+		OrderID:    redemptionData.OrderID,
+		UserID:     redemptionData.UserID,
+		RedeemedAt: redemptionData.RedeemedAt,
+		ReleasedAt: fromSQLNull(redemptionData.ReleasedAt),
+	}, nil
+}
+
+func (r *promoRedemptionRepository) CountActive(code string, userID *uuid.UUID) (_ int, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("count_active", "promo_redemption", status).Observe(time.Since(start).Seconds())
+	}()
+
+	query := `SELECT COUNT(*) FROM promo_redemption WHERE code = ? AND released_at IS NULL`
+	args := []interface{}{code}
+	if userID != nil {
+		query += ` AND user_id = ?`
+		args = append(args, *userID)
+	}
+
+	var count int
+	err = r.client.GetContext(r.ctx, &count, query, args...)
+	return count, errors.WithStack(err)
+}
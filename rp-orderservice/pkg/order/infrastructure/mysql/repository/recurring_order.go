@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewRecurringOrderRepository(ctx context.Context, client mysql.ClientContext) model.RecurringOrderRepository {
+	return &recurringOrderRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type recurringOrderRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *recurringOrderRepository) NextID() (uuid.UUID, error) {
+	return uuid.NewV7()
+}
+
+func (r *recurringOrderRepository) Store(recurringOrder model.RecurringOrder) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "recurring_order", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO recurring_order (recurring_order_id, user_id, interval_seconds, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE status=VALUES(status), updated_at=VALUES(updated_at)`,
+		recurringOrder.RecurringOrderID, recurringOrder.UserID, int64(recurringOrder.Interval.Seconds()),
+		recurringOrder.Status, recurringOrder.CreatedAt, recurringOrder.UpdatedAt,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// позиции регулярного заказа после создания не меняются
+	for position, item := range recurringOrder.Items {
+		_, err = r.client.ExecContext(r.ctx,
+			`INSERT IGNORE INTO recurring_order_item (recurring_order_id, product_id, quantity, position) VALUES (?, ?, ?, ?)`,
+			recurringOrder.RecurringOrderID, item.ProductID, item.Quantity, position,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *recurringOrderRepository) Find(recurringOrderID uuid.UUID) (_ *model.RecurringOrder, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrRecurringOrderNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "recurring_order", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var recurringOrderData struct {
+		RecurringOrderID uuid.UUID `db:"recurring_order_id"`
+		UserID           uuid.UUID `db:"user_id"`
+		IntervalSeconds  int64     `db:"interval_seconds"`
+		Status           int       `db:"status"`
+		CreatedAt        time.Time `db:"created_at"`
+		UpdatedAt        time.Time `db:"updated_at"`
+	}
+	err = r.client.GetContext(r.ctx, &recurringOrderData,
+		`SELECT recurring_order_id, user_id, interval_seconds, status, created_at, updated_at FROM recurring_order WHERE recurring_order_id = ?`,
+		recurringOrderID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrRecurringOrderNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	var itemsData []struct {
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+	}
+	err = r.client.SelectContext(r.ctx, &itemsData,
+		`SELECT product_id, quantity FROM recurring_order_item WHERE recurring_order_id = ? ORDER BY position`,
+		recurringOrderID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]model.OrderItem, len(itemsData))
+	for i, itemData := range itemsData {
+		items[i] = model.OrderItem{
+			ProductID: itemData.ProductID,
+			Quantity:  itemData.Quantity,
+		}
+	}
+
+	return &model.RecurringOrder{
+		RecurringOrderID: recurringOrderData.RecurringOrderID,
+		UserID:           recurringOrderData.UserID,
+		Items:            items,
+		Interval:         time.Duration(recurringOrderData.IntervalSeconds) * time.Second,
+		Status:           model.RecurringOrderStatus(recurringOrderData.Status),
+		CreatedAt:        recurringOrderData.CreatedAt,
+		UpdatedAt:        recurringOrderData.UpdatedAt,
+	}, nil
+}
+
+func (r *recurringOrderRepository) StoreRun(run model.RecurringOrderRun) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store_run", "recurring_order_run", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO recurring_order_run (run_id, recurring_order_id, order_id, status, error, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE order_id=VALUES(order_id), status=VALUES(status), error=VALUES(error), updated_at=VALUES(updated_at)`,
+		run.RunID, run.RecurringOrderID, toSQLNull(run.OrderID), run.Status, run.Error, run.CreatedAt, run.UpdatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *recurringOrderRepository) FindRunForOrder(orderID uuid.UUID) (_ *model.RecurringOrderRun, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrRecurringRunNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_run_for_order", "recurring_order_run", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var runData struct {
+		RunID            uuid.UUID           `db:"run_id"`
+		RecurringOrderID uuid.UUID           `db:"recurring_order_id"`
+		OrderID          sql.Null[uuid.UUID] `db:"order_id"`
+		Status           int                 `db:"status"`
+		Error            string              `db:"error"`
+		CreatedAt        time.Time           `db:"created_at"`
+		UpdatedAt        time.Time           `db:"updated_at"`
+	}
+	err = r.client.GetContext(r.ctx, &runData,
+		`SELECT run_id, recurring_order_id, order_id, status, error, created_at, updated_at FROM recurring_order_run WHERE order_id = ?`,
+		orderID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrRecurringRunNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.RecurringOrderRun{
+		RunID:            runData.RunID,
+		RecurringOrderID: runData.RecurringOrderID,
+		OrderID:          fromSQLNull(runData.OrderID),
+		Status:           model.RecurringRunStatus(runData.Status),
+		Error:            runData.Error,
+		CreatedAt:        runData.CreatedAt,
+		UpdatedAt:        runData.UpdatedAt,
+	}, nil
+}
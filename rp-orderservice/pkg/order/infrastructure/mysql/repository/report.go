@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewReportRepository(ctx context.Context, client mysql.ClientContext) model.ReportRepository {
+	return &reportRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type reportRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *reportRepository) AddDailySales(day time.Time, sales model.DailySales) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("add", "report_daily_sales", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO report_daily_sales (day, paid_orders, revenue, refunded_orders, refunds) VALUES (?, ?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE
+			paid_orders=paid_orders + VALUES(paid_orders),
+			revenue=revenue + VALUES(revenue),
+			refunded_orders=refunded_orders + VALUES(refunded_orders),
+			refunds=refunds + VALUES(refunds)`,
+		day, sales.PaidOrders, sales.Revenue, sales.RefundedOrders, sales.Refunds,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *reportRepository) AddProductSales(day time.Time, sales []model.ProductSales) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("add", "report_daily_product", status).Observe(time.Since(start).Seconds())
+	}()
+
+	for _, s := range sales {
+		_, err = r.client.ExecContext(r.ctx,
+			`INSERT INTO report_daily_product (day, product_id, quantity, revenue) VALUES (?, ?, ?, ?)
+			 ON DUPLICATE KEY UPDATE quantity=quantity + VALUES(quantity), revenue=revenue + VALUES(revenue)`,
+			day, s.ProductID, s.Quantity, s.Revenue,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func (r *reportRepository) AddStatusCount(day time.Time, orderStatus model.OrderStatus, count int) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("add", "report_daily_status", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO report_daily_status (day, status, orders) VALUES (?, ?, ?)
+		 ON DUPLICATE KEY UPDATE orders=orders + VALUES(orders)`,
+		day, orderStatus, count,
+	)
+	return errors.WithStack(err)
+}
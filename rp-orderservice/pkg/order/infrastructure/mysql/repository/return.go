@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewReturnRepository(ctx context.Context, client mysql.ClientContext) model.ReturnRepository {
+	return &returnRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type returnRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *returnRepository) NextID() (uuid.UUID, error) {
+	return uuid.NewV7()
+}
+
+func (r *returnRepository) Store(orderReturn model.Return) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "order_return", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO order_return (return_id, order_id, user_id, refund_amount, status, reason, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE status=VALUES(status), reason=VALUES(reason), updated_at=VALUES(updated_at)`,
+		orderReturn.ReturnID, orderReturn.OrderID, orderReturn.UserID, orderReturn.RefundAmount,
+		orderReturn.Status, orderReturn.Reason, orderReturn.CreatedAt, orderReturn.UpdatedAt,
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// состав возврата после создания не меняется
+	for position, item := range orderReturn.Items {
+		_, err = r.client.ExecContext(r.ctx,
+			`INSERT IGNORE INTO order_return_item (return_id, product_id, quantity, position) VALUES (?, ?, ?, ?)`,
+			orderReturn.ReturnID, item.ProductID, item.Quantity, position,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *returnRepository) Find(returnID uuid.UUID) (_ *model.Return, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil && !errors.Is(err, model.ErrReturnNotFound) {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "order_return", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var returnData sqlReturn
+	err = r.client.GetContext(r.ctx, &returnData,
+		`SELECT return_id, order_id, user_id, refund_amount, status, reason, created_at, updated_at FROM order_return WHERE return_id = ?`,
+		returnID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrReturnNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	items, err := r.findItems(`WHERE return_id = ?`, returnID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderReturn := returnData.toModel(items[returnID])
+	return &orderReturn, nil
+}
+
+func (r *returnRepository) FindForOrder(orderID uuid.UUID) (_ []model.Return, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_for_order", "order_return", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var returnsData []sqlReturn
+	err = r.client.SelectContext(r.ctx, &returnsData,
+		`SELECT return_id, order_id, user_id, refund_amount, status, reason, created_at, updated_at FROM order_return WHERE order_id = ? ORDER BY return_id`,
+		orderID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items, err := r.findItems(`WHERE return_id IN (SELECT return_id FROM order_return WHERE order_id = ?)`, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	returns := make([]model.Return, len(returnsData))
+	for i, returnData := range returnsData {
+		returns[i] = returnData.toModel(items[returnData.ReturnID])
+	}
+	return returns, nil
+}
+
+func (r *returnRepository) findItems(where string, arg any) (map[uuid.UUID][]model.ReturnItem, error) {
+	var itemsData []struct {
+		ReturnID  uuid.UUID `db:"return_id"`
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+	}
+	err := r.client.SelectContext(r.ctx, &itemsData,
+		`SELECT return_id, product_id, quantity FROM order_return_item `+where+` ORDER BY return_id, position`,
+		arg,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make(map[uuid.UUID][]model.ReturnItem)
+	for _, itemData := range itemsData {
+		items[itemData.ReturnID] = append(items[itemData.ReturnID], model.ReturnItem{
+			ProductID: itemData.ProductID,
+			Quantity:  itemData.Quantity,
+		})
+	}
+	return items, nil
+}
+
+type sqlReturn struct {
+	ReturnID     uuid.UUID `db:"return_id"`
+	OrderID      uuid.UUID `db:"order_id"`
+	UserID       uuid.UUID `db:"user_id"`
+	RefundAmount int64     `db:"refund_amount"`
+	Status       int       `db:"status"`
+	Reason       string    `db:"reason"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+func (s sqlReturn) toModel(items []model.ReturnItem) model.Return {
+	return model.Return{
+		ReturnID:     s.ReturnID,
+		OrderID:      s.OrderID,
+		UserID:       s.UserID,
+		Items:        items,
+		RefundAmount: s.RefundAmount,
+		Status:       model.ReturnStatus(s.Status),
+		Reason:       s.Reason,
+		CreatedAt:    s.CreatedAt,
+		UpdatedAt:    s.UpdatedAt,
+	}
+}
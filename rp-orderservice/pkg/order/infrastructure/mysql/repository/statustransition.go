@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/domain/model"
+	"orderservice/pkg/order/infrastructure/metrics"
+)
+
+func NewStatusTransitionRepository(ctx context.Context, client mysql.ClientContext) model.StatusTransitionRepository {
+	return &statusTransitionRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type statusTransitionRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *statusTransitionRepository) Append(transition model.StatusTransition) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("append", "order_status_transition", status).Observe(time.Since(start).Seconds())
+	}()
+
+	// UUIDv7 растет со временем, по нему история читается в порядке записи
+	transitionID, err := uuid.NewV7()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO order_status_transition (transition_id, order_id, from_status, to_status, reason, actor, occurred_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		transitionID, transition.OrderID, transition.From, transition.To, transition.Reason, transition.Actor, transition.OccurredAt,
+	)
+	return errors.WithStack(err)
+}
@@ -22,6 +22,10 @@ func (r *repositoryProvider) OrderRepository(ctx context.Context) model.OrderRep
 	return repository.NewOrderRepository(ctx, r.client)
 }
 
+func (r *repositoryProvider) StatusTransitionRepository(ctx context.Context) model.StatusTransitionRepository {
+	return repository.NewStatusTransitionRepository(ctx, r.client)
+}
+
 func (r *repositoryProvider) LocalUserRepository(ctx context.Context) model.LocalUserRepository {
 	return repository.NewLocalUserRepository(ctx, r.client)
 }
@@ -29,3 +33,31 @@ func (r *repositoryProvider) LocalUserRepository(ctx context.Context) model.Loca
 func (r *repositoryProvider) LocalProductRepository(ctx context.Context) model.LocalProductRepository {
 	return repository.NewLocalProductRepository(ctx, r.client)
 }
+
+func (r *repositoryProvider) IdempotencyKeyRepository(ctx context.Context) model.IdempotencyKeyRepository {
+	return repository.NewIdempotencyKeyRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) CartRepository(ctx context.Context) model.CartRepository {
+	return repository.NewCartRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) ReturnRepository(ctx context.Context) model.ReturnRepository {
+	return repository.NewReturnRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) PromoCodeRepository(ctx context.Context) model.PromoCodeRepository {
+	return repository.NewPromoCodeRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) PromoRedemptionRepository(ctx context.Context) model.PromoRedemptionRepository {
+	return repository.NewPromoRedemptionRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) ReportRepository(ctx context.Context) model.ReportRepository {
+	return repository.NewReportRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) RecurringOrderRepository(ctx context.Context) model.RecurringOrderRepository {
+	return repository.NewRecurringOrderRepository(ctx, r.client)
+}
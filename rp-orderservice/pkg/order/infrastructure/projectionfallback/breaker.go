@@ -0,0 +1,67 @@
+package projectionfallback
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// circuitBreaker перестает ходить в сервис после failureThreshold ошибок подряд.
+// Через openTimeout пропускает один пробный запрос: успех закрывает цепь, ошибка открывает снова
+type circuitBreaker struct {
+	failureThreshold int
+	openTimeout      time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(failureThreshold int, openTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+	}
+}
+
+func (b *circuitBreaker) Execute(f func() error) error {
+	if !b.allow() {
+		return errors.WithStack(ErrCircuitOpen)
+	}
+	err := f()
+	b.record(err)
+	return err
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.failureThreshold {
+		return true
+	}
+	if b.probing || time.Since(b.openedAt) < b.openTimeout {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if err == nil {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.failureThreshold {
+		b.openedAt = time.Now()
+	}
+}
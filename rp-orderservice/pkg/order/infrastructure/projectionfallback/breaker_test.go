@@ -0,0 +1,80 @@
+package projectionfallback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	errUpstream := errors.New("upstream unavailable")
+	succeed := func() error { return nil }
+	fail := func() error { return errUpstream }
+
+	// openAfterTimeout сдвигает момент открытия в прошлое, будто openTimeout уже прошел
+	openAfterTimeout := func(b *circuitBreaker) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.openedAt = time.Now().Add(-b.openTimeout)
+	}
+
+	t.Run("Closed circuit passes calls and resets on success", func(t *testing.T) {
+		b := newCircuitBreaker(2, time.Minute)
+
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		assert.NoError(t, b.Execute(succeed))
+		// счетчик сбросился, одна ошибка снова не открывает цепь
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		assert.NoError(t, b.Execute(succeed))
+	})
+
+	t.Run("Opens after threshold of consecutive failures", func(t *testing.T) {
+		b := newCircuitBreaker(2, time.Minute)
+
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+
+		called := false
+		err := b.Execute(func() error {
+			called = true
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assert.False(t, called)
+	})
+
+	t.Run("Half-open lets a single probe through", func(t *testing.T) {
+		b := newCircuitBreaker(1, time.Minute)
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		openAfterTimeout(b)
+
+		var concurrentErr error
+		err := b.Execute(func() error {
+			// пока идет пробный запрос, остальные получают отказ
+			concurrentErr = b.Execute(succeed)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, concurrentErr, ErrCircuitOpen)
+	})
+
+	t.Run("Successful probe closes the circuit", func(t *testing.T) {
+		b := newCircuitBreaker(1, time.Minute)
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		openAfterTimeout(b)
+
+		assert.NoError(t, b.Execute(succeed))
+		assert.NoError(t, b.Execute(succeed))
+	})
+
+	t.Run("Failed probe opens the circuit again", func(t *testing.T) {
+		b := newCircuitBreaker(1, time.Minute)
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		openAfterTimeout(b)
+
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+		assert.ErrorIs(t, b.Execute(succeed), ErrCircuitOpen)
+	})
+}
@@ -0,0 +1,105 @@
+package projectionfallback
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/api/client/productinternal"
+	"orderservice/api/client/userinternal"
+	appservice "orderservice/pkg/order/application/service"
+	"orderservice/pkg/order/domain/model"
+)
+
+type Config struct {
+	// Timeout ограничивает весь fallback одного заказа, а не каждый запрос
+	Timeout          time.Duration
+	FailureThreshold int
+	OpenTimeout      time.Duration
+}
+
+func NewProjectionFallback(
+	userClient userinternal.UserInternalServiceClient,
+	productClient productinternal.ProductInternalServiceClient,
+	config Config,
+) appservice.ProjectionFallback {
+	return &projectionFallback{
+		userClient:     userClient,
+		productClient:  productClient,
+		timeout:        config.Timeout,
+		userBreaker:    newCircuitBreaker(config.FailureThreshold, config.OpenTimeout),
+		productBreaker: newCircuitBreaker(config.FailureThreshold, config.OpenTimeout),
+	}
+}
+
+type projectionFallback struct {
+	userClient     userinternal.UserInternalServiceClient
+	productClient  productinternal.ProductInternalServiceClient
+	timeout        time.Duration
+	userBreaker    *circuitBreaker
+	productBreaker *circuitBreaker
+}
+
+func (f *projectionFallback) FindUser(ctx context.Context, userID uuid.UUID) (*model.LocalUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	var response *userinternal.FindUserResponse
+	err := f.userBreaker.Execute(func() error {
+		var err error
+		response, err = f.userClient.FindUser(ctx, &userinternal.FindUserRequest{UserID: userID.String()})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.User == nil {
+		return nil, errors.WithStack(model.ErrUserNotFound)
+	}
+
+	user := &model.LocalUser{
+		UserID: userID,
+		Login:  response.User.Login,
+	}
+	if response.User.Status == userinternal.UserStatus_Blocked {
+		user.Status = model.LocalUserBlocked
+	}
+	if response.User.Status == userinternal.UserStatus_Deleted {
+		// точное время удаления знает только событие, для проекции хватит текущего
+		deletedAt := time.Now()
+		user.DeletedAt = &deletedAt
+	}
+	return user, nil
+}
+
+func (f *projectionFallback) FindProducts(ctx context.Context, productIDs []uuid.UUID) ([]model.LocalProduct, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	products := make([]model.LocalProduct, 0, len(productIDs))
+	for _, productID := range productIDs {
+		var response *productinternal.FindProductResponse
+		err := f.productBreaker.Execute(func() error {
+			var err error
+			response, err = f.productClient.FindProduct(ctx, &productinternal.FindProductRequest{ProductID: productID.String()})
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if response.Product == nil {
+			continue
+		}
+
+		products = append(products, model.LocalProduct{
+			ProductID:   productID,
+			Name:        response.Product.Name,
+			Description: response.Product.Description,
+			Price:       response.Product.Price,
+			Version:     response.Product.Version,
+		})
+	}
+	return products, nil
+}
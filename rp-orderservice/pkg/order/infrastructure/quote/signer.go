@@ -0,0 +1,117 @@
+package quote
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"orderservice/pkg/order/application/service"
+	"orderservice/pkg/order/domain/model"
+)
+
+// NewSigner подписывает котировки HMAC-SHA256. Токен - base64(payload).base64(подпись)
+func NewSigner(secret []byte) service.QuoteSigner {
+	return &signer{secret: secret}
+}
+
+type signer struct {
+	secret []byte
+}
+
+func (s *signer) Sign(quote model.Quote) (string, error) {
+	items := make([]quoteItem, len(quote.Items))
+	for i, item := range quote.Items {
+		items[i] = quoteItem{
+			ProductID: item.ProductID.String(),
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		}
+	}
+
+	payload, err := json.Marshal(quotePayload{
+		UserID:     quote.UserID.String(),
+		Items:      items,
+		PromoCode:  quote.PromoCode,
+		TotalPrice: quote.TotalPrice,
+		ExpiresAt:  quote.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(s.sign(payload)), nil
+}
+
+func (s *signer) Verify(token string) (*model.Quote, error) {
+	encoding := base64.RawURLEncoding
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, errors.WithStack(model.ErrInvalidQuote)
+	}
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, errors.WithStack(model.ErrInvalidQuote)
+	}
+	signature, err := encoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.sign(payload)) {
+		return nil, errors.WithStack(model.ErrInvalidQuote)
+	}
+
+	var data quotePayload
+	err = json.Unmarshal(payload, &data)
+	if err != nil {
+		return nil, errors.WithStack(model.ErrInvalidQuote)
+	}
+
+	userID, err := uuid.Parse(data.UserID)
+	if err != nil {
+		return nil, errors.WithStack(model.ErrInvalidQuote)
+	}
+	items := make([]model.OrderItem, len(data.Items))
+	for i, item := range data.Items {
+		productID, err := uuid.Parse(item.ProductID)
+		if err != nil {
+			return nil, errors.WithStack(model.ErrInvalidQuote)
+		}
+		items[i] = model.OrderItem{
+			ProductID: productID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		}
+	}
+
+	return &model.Quote{
+		UserID:     userID,
+		Items:      items,
+		PromoCode:  data.PromoCode,
+		TotalPrice: data.TotalPrice,
+		ExpiresAt:  time.Unix(data.ExpiresAt, 0),
+	}, nil
+}
+
+func (s *signer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+type quoteItem struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+	Price     int64  `json:"price"`
+}
+
+type quotePayload struct {
+	UserID     string      `json:"user_id"`
+	Items      []quoteItem `json:"items"`
+	PromoCode  string      `json:"promo_code,omitempty"`
+	TotalPrice int64       `json:"total_price"`
+	ExpiresAt  int64       `json:"expires_at"`
+}
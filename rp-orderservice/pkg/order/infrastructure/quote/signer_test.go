@@ -0,0 +1,89 @@
+package quote
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+func TestSigner(t *testing.T) {
+	quote := model.Quote{
+		UserID: uuid.New(),
+		Items: []model.OrderItem{
+			{ProductID: uuid.New(), Quantity: 2, Price: 300},
+			{ProductID: uuid.New(), Quantity: 1, Price: 400},
+		},
+		PromoCode:  "SALE10",
+		TotalPrice: 900,
+		ExpiresAt:  time.Unix(1722266000, 0),
+	}
+	signer := NewSigner([]byte("secret"))
+
+	token, err := signer.Sign(quote)
+	assert.NoError(t, err)
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	assert.True(t, ok)
+
+	t.Run("Round trip", func(t *testing.T) {
+		verified, err := signer.Verify(token)
+		assert.NoError(t, err)
+		assert.Equal(t, quote.UserID, verified.UserID)
+		assert.Equal(t, quote.Items, verified.Items)
+		assert.Equal(t, quote.PromoCode, verified.PromoCode)
+		assert.Equal(t, quote.TotalPrice, verified.TotalPrice)
+		assert.True(t, quote.ExpiresAt.Equal(verified.ExpiresAt))
+	})
+
+	t.Run("Tampered payload", func(t *testing.T) {
+		payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+		assert.NoError(t, err)
+		// клиент пытается заплатить меньше, оставив старую подпись
+		tampered := strings.Replace(string(payload), `"total_price":900`, `"total_price":1`, 1)
+		assert.NotEqual(t, string(payload), tampered)
+
+		_, err = signer.Verify(base64.RawURLEncoding.EncodeToString([]byte(tampered)) + "." + encodedSignature)
+		assert.ErrorIs(t, err, model.ErrInvalidQuote)
+	})
+
+	t.Run("Tampered signature", func(t *testing.T) {
+		signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+		assert.NoError(t, err)
+		signature[0] ^= 0xff
+
+		_, err = signer.Verify(encodedPayload + "." + base64.RawURLEncoding.EncodeToString(signature))
+		assert.ErrorIs(t, err, model.ErrInvalidQuote)
+	})
+
+	t.Run("Wrong secret", func(t *testing.T) {
+		_, err := NewSigner([]byte("other secret")).Verify(token)
+		assert.ErrorIs(t, err, model.ErrInvalidQuote)
+	})
+
+	t.Run("Malformed token", func(t *testing.T) {
+		for _, malformed := range []string{
+			"",
+			"no-separator",
+			"!!!." + encodedSignature,
+			encodedPayload + ".!!!",
+			// подпись верная, но внутри не JSON котировки
+			signedPayload(t, []byte("secret"), []byte("not json")),
+			signedPayload(t, []byte("secret"), []byte(`{"user_id":"not uuid"}`)),
+		} {
+			_, err := signer.Verify(malformed)
+			assert.ErrorIs(t, err, model.ErrInvalidQuote, malformed)
+		}
+	})
+}
+
+func signedPayload(t *testing.T, secret, payload []byte) string {
+	t.Helper()
+	s := &signer{secret: secret}
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(s.sign(payload))
+}
@@ -1,15 +1,104 @@
 package activity
 
 import (
+	"context"
+
+	"github.com/google/uuid"
+
 	"orderservice/pkg/order/application/service"
+	"orderservice/pkg/order/domain/model"
 )
 
-func NewOrderServiceActivities(orderService service.OrderService) *OrderServiceActivities {
+func NewOrderServiceActivities(
+	orderService service.OrderService,
+	returnService service.ReturnService,
+	recurringOrderService service.RecurringOrderService,
+) *OrderServiceActivities {
 	return &OrderServiceActivities{
-		orderService: orderService,
+		orderService:          orderService,
+		returnService:         returnService,
+		recurringOrderService: recurringOrderService,
 	}
 }
 
 type OrderServiceActivities struct {
-	orderService service.OrderService
+	orderService          service.OrderService
+	returnService         service.ReturnService
+	recurringOrderService service.RecurringOrderService
+}
+
+func (a *OrderServiceActivities) SetOrderAwaitingApproval(ctx context.Context, orderIDStr string) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+	return a.orderService.SetAwaitingApproval(ctx, orderID)
+}
+
+func (a *OrderServiceActivities) SetOrderPaymentPending(ctx context.Context, orderIDStr string) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+	return a.orderService.SetPaymentPending(ctx, orderID)
+}
+
+func (a *OrderServiceActivities) MarkOrderPaid(ctx context.Context, orderIDStr string) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+	return a.orderService.HandlePaymentResult(ctx, orderID, true)
+}
+
+func (a *OrderServiceActivities) CancelOrder(ctx context.Context, orderIDStr, reason string, actor model.Actor) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+	// компенсации, запланированные до появления actor, запускала сама сага
+	if actor == "" {
+		actor = model.ActorWorkflow
+	}
+	return a.orderService.CancelOrder(ctx, orderID, reason, actor)
+}
+
+func (a *OrderServiceActivities) CancelRefundedOrder(ctx context.Context, orderIDStr, reason string, actor model.Actor) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+	// отмены, запланированные до появления actor, приходили только от клиента
+	if actor == "" {
+		actor = model.ActorCustomer
+	}
+	return a.orderService.CancelAfterRefund(ctx, orderID, reason, actor)
+}
+
+func (a *OrderServiceActivities) CompleteOrder(ctx context.Context, orderIDStr string) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+	return a.orderService.CompleteOrder(ctx, orderID)
+}
+
+func (a *OrderServiceActivities) CompleteReturn(ctx context.Context, returnIDStr string) error {
+	returnID, err := uuid.Parse(returnIDStr)
+	if err != nil {
+		return err
+	}
+	return a.returnService.CompleteReturn(ctx, returnID)
+}
+
+func (a *OrderServiceActivities) PlaceRecurringOrder(ctx context.Context, recurringOrderIDStr, runIDStr string) error {
+	recurringOrderID, err := uuid.Parse(recurringOrderIDStr)
+	if err != nil {
+		return err
+	}
+	runID, err := uuid.Parse(runIDStr)
+	if err != nil {
+		return err
+	}
+	return a.recurringOrderService.PlaceOrder(ctx, recurringOrderID, runID)
 }
@@ -0,0 +1,73 @@
+package workflowoutbox
+
+import (
+	"encoding/json"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+	"github.com/pkg/errors"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/infrastructure/temporal/workflows"
+)
+
+func NewCommandSerializer() outbox.EventSerializer[outbox.Event] {
+	return &commandSerializer{}
+}
+
+type commandSerializer struct{}
+
+func (s commandSerializer) Serialize(event outbox.Event) (string, error) {
+	switch e := event.(type) {
+	case *appmodel.StartCreateOrderWorkflow:
+		items := make([]workflows.OrderItem, len(e.Items))
+		for i, item := range e.Items {
+			items[i] = workflows.OrderItem{
+				ProductID: item.ProductID.String(),
+				Quantity:  item.Quantity,
+			}
+		}
+		b, err := json.Marshal(workflows.CreateOrderParams{
+			OrderID:           e.OrderID.String(),
+			UserID:            e.UserID.String(),
+			Items:             items,
+			TotalPrice:        e.TotalPrice,
+			SkipPaymentWindow: e.SkipPaymentWindow,
+		})
+		return string(b), errors.WithStack(err)
+
+	case *appmodel.StartCompleteOrderWorkflow:
+		b, err := json.Marshal(workflows.CompleteOrderParams{
+			OrderID: e.OrderID.String(),
+		})
+		return string(b), errors.WithStack(err)
+
+	case *appmodel.StartReturnOrderWorkflow:
+		items := make([]workflows.OrderItem, len(e.Items))
+		for i, item := range e.Items {
+			items[i] = workflows.OrderItem{
+				ProductID: item.ProductID.String(),
+				Quantity:  item.Quantity,
+			}
+		}
+		b, err := json.Marshal(workflows.ReturnOrderParams{
+			ReturnID:     e.ReturnID.String(),
+			OrderID:      e.OrderID.String(),
+			UserID:       e.UserID.String(),
+			Items:        items,
+			RefundAmount: e.RefundAmount,
+		})
+		return string(b), errors.WithStack(err)
+
+	case *appmodel.SyncRecurringOrderSchedule:
+		b, err := json.Marshal(workflows.RecurringOrderScheduleParams{
+			RecurringOrderID: e.RecurringOrderID.String(),
+			Interval:         e.Interval,
+			Paused:           e.Paused,
+			Cancelled:        e.Cancelled,
+		})
+		return string(b), errors.WithStack(err)
+
+	default:
+		return "", errors.Errorf("unknown workflow command %q", event.Type())
+	}
+}
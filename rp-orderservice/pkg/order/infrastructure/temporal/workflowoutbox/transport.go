@@ -0,0 +1,192 @@
+package workflowoutbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/logging"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/outbox"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+
+	appmodel "orderservice/pkg/order/application/model"
+	"orderservice/pkg/order/infrastructure/temporal/workflows"
+)
+
+// TransportName - отдельный outbox для команд запуска workflow, чтобы не смешивать их с доменными событиями
+const TransportName = "workflow"
+
+type WorkflowStarter interface {
+	ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (client.WorkflowRun, error)
+	ScheduleClient() client.ScheduleClient
+}
+
+// Approval - ручное одобрение крупных заказов, нулевой Threshold его выключает
+type Approval struct {
+	Threshold int64
+	Timeout   time.Duration
+}
+
+func NewTransport(logger logging.Logger, starter WorkflowStarter, paymentWindow, holdTTL, completeAfter time.Duration, approval Approval) outbox.Transport {
+	return &transport{
+		logger:        logger,
+		starter:       starter,
+		paymentWindow: paymentWindow,
+		holdTTL:       holdTTL,
+		completeAfter: completeAfter,
+		approval:      approval,
+	}
+}
+
+type transport struct {
+	logger        logging.Logger
+	starter       WorkflowStarter
+	paymentWindow time.Duration
+	holdTTL       time.Duration
+	completeAfter time.Duration
+	approval      Approval
+}
+
+func (t *transport) HandleEvents(ctx context.Context, correlationID, eventType, payload string) error {
+	l := t.logger.WithFields(logging.Fields{
+		"correlationID": correlationID,
+		"eventType":     eventType,
+		"payload":       payload,
+	})
+
+	var err error
+	switch eventType {
+	case appmodel.StartCreateOrderWorkflow{}.Type():
+		err = t.startCreateOrderWorkflow(ctx, payload)
+	case appmodel.StartCompleteOrderWorkflow{}.Type():
+		err = t.startCompleteOrderWorkflow(ctx, payload)
+	case appmodel.StartReturnOrderWorkflow{}.Type():
+		err = t.startReturnOrderWorkflow(ctx, payload)
+	case appmodel.SyncRecurringOrderSchedule{}.Type():
+		err = t.syncRecurringOrderSchedule(ctx, payload)
+	default:
+		err = errors.Errorf("unknown workflow command %q", eventType)
+	}
+	if err != nil {
+		l.Error(err, "failed to start workflow")
+		return err
+	}
+	l.Info("successfully started workflow")
+	return nil
+}
+
+func (t *transport) startCreateOrderWorkflow(ctx context.Context, payload string) error {
+	var params workflows.CreateOrderParams
+	err := json.Unmarshal([]byte(payload), &params)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !params.SkipPaymentWindow {
+		params.PaymentWindow = t.paymentWindow
+	}
+	params.HoldTTL = t.holdTTL
+	params.ApprovalThreshold = t.approval.Threshold
+	params.ApprovalTimeout = t.approval.Timeout
+
+	// outbox доставляет команду минимум один раз: ID workflow строится из заказа,
+	// а повторный запуск уже отработавшего workflow запрещен
+	_, err = t.starter.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    workflows.CreateOrderWorkflowID(params.OrderID),
+		TaskQueue:             workflows.OrderTaskQueue,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, workflows.CreateOrderWorkflow, params)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	return err
+}
+
+func (t *transport) startCompleteOrderWorkflow(ctx context.Context, payload string) error {
+	var params workflows.CompleteOrderParams
+	err := json.Unmarshal([]byte(payload), &params)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	params.CompleteAfter = t.completeAfter
+
+	_, err = t.starter.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    workflows.CompleteOrderWorkflowID(params.OrderID),
+		TaskQueue:             workflows.OrderTaskQueue,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, workflows.CompleteOrderWorkflow, params)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	return err
+}
+
+func (t *transport) startReturnOrderWorkflow(ctx context.Context, payload string) error {
+	var params workflows.ReturnOrderParams
+	err := json.Unmarshal([]byte(payload), &params)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = t.starter.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    workflows.ReturnOrderWorkflowID(params.ReturnID),
+		TaskQueue:             workflows.OrderTaskQueue,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, workflows.ReturnOrderWorkflow, params)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	return err
+}
+
+// syncRecurringOrderSchedule приводит расписание к состоянию регулярного заказа.
+// Команда может прийти повторно, поэтому уже созданное или уже удаленное расписание - не ошибка
+func (t *transport) syncRecurringOrderSchedule(ctx context.Context, payload string) error {
+	var params workflows.RecurringOrderScheduleParams
+	err := json.Unmarshal([]byte(payload), &params)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	scheduleClient := t.starter.ScheduleClient()
+	handle := scheduleClient.GetHandle(ctx, workflows.RecurringOrderScheduleID(params.RecurringOrderID))
+
+	if params.Cancelled {
+		err = handle.Delete(ctx)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = scheduleClient.Create(ctx, client.ScheduleOptions{
+		ID: workflows.RecurringOrderScheduleID(params.RecurringOrderID),
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{{Every: params.Interval}},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        workflows.RecurringOrderWorkflowID(params.RecurringOrderID),
+			Workflow:  workflows.RecurringOrderWorkflow,
+			Args:      []interface{}{workflows.RecurringOrderParams{RecurringOrderID: params.RecurringOrderID}},
+			TaskQueue: workflows.OrderTaskQueue,
+		},
+		// пропущенный из-за простоя запуск не догоняется, а новый не стартует, пока идет предыдущий
+		Overlap: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Paused:  params.Paused,
+	})
+	if !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		return err
+	}
+
+	if params.Paused {
+		return handle.Pause(ctx, client.SchedulePauseOptions{Note: "Paused by customer"})
+	}
+	return handle.Unpause(ctx, client.ScheduleUnpauseOptions{Note: "Resumed by customer"})
+}
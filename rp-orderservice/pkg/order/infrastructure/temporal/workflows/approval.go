@@ -0,0 +1,60 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+const (
+	// ApproveOrderSignal и RejectOrderSignal - решение по заказу, ждущему ручного одобрения
+	ApproveOrderSignal = "approve_order"
+	RejectOrderSignal  = "reject_order"
+)
+
+type RejectOrderRequest struct {
+	Reason string
+	// Actor - кто отклонил заказ, пишется в историю статусов
+	Actor model.Actor
+}
+
+type approvalDecision int
+
+const (
+	approvalApproved approvalDecision = iota
+	approvalRejected
+	approvalTimedOut
+	approvalCancelled
+)
+
+// awaitApproval ждет решения по заказу. Клиент может отменить заказ, пока решения нет,
+// тогда вместе с решением возвращается запрос на отмену. Нулевой timeout - ждать без ограничения
+func awaitApproval(ctx workflow.Context, cancelChannel workflow.ReceiveChannel, timeout time.Duration) (approvalDecision, RejectOrderRequest, CancelOrderRequest) {
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+
+	decision := approvalTimedOut
+	var rejectRequest RejectOrderRequest
+	var cancelRequest CancelOrderRequest
+
+	selector := workflow.NewSelector(ctx)
+	if timeout > 0 {
+		selector.AddFuture(workflow.NewTimer(timerCtx, timeout), func(workflow.Future) {})
+	}
+	selector.AddReceive(workflow.GetSignalChannel(ctx, ApproveOrderSignal), func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, nil)
+		decision = approvalApproved
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, RejectOrderSignal), func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, &rejectRequest)
+		decision = approvalRejected
+	})
+	selector.AddReceive(cancelChannel, func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, &cancelRequest)
+		decision = approvalCancelled
+	})
+	selector.Select(ctx)
+	return decision, rejectRequest, cancelRequest
+}
@@ -0,0 +1,79 @@
+package workflows
+
+import (
+	"go.temporal.io/sdk/workflow"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+// CancelOrderSignal - сигнал CreateOrderWorkflow об отмене заказа клиентом
+const CancelOrderSignal = "cancel_order"
+
+type CancelOrderRequest struct {
+	Reason string
+	// Actor - кто отменяет заказ, пишется в историю статусов
+	Actor model.Actor
+}
+
+type CancelOrderParams struct {
+	OrderID    string
+	UserID     string
+	TotalPrice int64
+	Reason     string
+	Actor      model.Actor
+}
+
+// CancelOrderWorkflow отменяет заказ, для которого CreateOrderWorkflow уже завершился
+func CancelOrderWorkflow(ctx workflow.Context, params CancelOrderParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting CancelOrderWorkflow", "OrderID", params.OrderID)
+
+	tracker, err := newProgressTracker(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = compensateCancellation(ctx, tracker, params)
+	if err != nil {
+		logger.Error("Failed to cancel order", "OrderID", params.OrderID, "Error", err)
+		return err
+	}
+
+	tracker.complete()
+	logger.Info("Order cancelled", "OrderID", params.OrderID)
+	return nil
+}
+
+// compensateCancellation возвращает деньги, отпускает холд, снимает резерв и отменяет заказ.
+// Все шаги идемпотентны: возврат, холд и резерв привязаны к заказу, повторная отмена ничего не делает
+func compensateCancellation(ctx workflow.Context, tracker *progressTracker, params CancelOrderParams) error {
+	options := activityOptions()
+
+	ctxPayment := workflow.WithActivityOptions(ctx, options)
+	ctxPayment = workflow.WithTaskQueue(ctxPayment, PaymentTaskQueue)
+
+	// если по заказу ничего не списали, paymentservice ничего и не вернет
+	err := tracker.run(ctxPayment, StepCompensating, "RefundPayment", nil, params.UserID, params.OrderID, params.TotalPrice)
+	if err != nil {
+		return err
+	}
+
+	// холд, который еще не списали, отпускаем. Без холда paymentservice ничего не сделает
+	err = tracker.run(ctxPayment, StepCompensating, "VoidPayment", nil, params.OrderID)
+	if err != nil {
+		return err
+	}
+
+	ctxProduct := workflow.WithActivityOptions(ctx, options)
+	ctxProduct = workflow.WithTaskQueue(ctxProduct, ProductTaskQueue)
+
+	err = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+	if err != nil {
+		return err
+	}
+
+	ctxOrder := workflow.WithActivityOptions(ctx, options)
+	ctxOrder = workflow.WithTaskQueue(ctxOrder, OrderTaskQueue)
+
+	return tracker.run(ctxOrder, StepCompensating, "CancelRefundedOrder", nil, params.OrderID, params.Reason, params.Actor)
+}
@@ -0,0 +1,40 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+type CompleteOrderParams struct {
+	OrderID string
+	// CompleteAfter - сколько ждать после доставки, прежде чем закрыть заказ
+	CompleteAfter time.Duration
+}
+
+func CompleteOrderWorkflowID(orderID string) string {
+	return "order_complete_" + orderID
+}
+
+// CompleteOrderWorkflow закрывает доставленный заказ, когда проходит отведенное после доставки время
+func CompleteOrderWorkflow(ctx workflow.Context, params CompleteOrderParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting CompleteOrderWorkflow", "OrderID", params.OrderID, "CompleteAfter", params.CompleteAfter)
+
+	err := workflow.Sleep(ctx, params.CompleteAfter)
+	if err != nil {
+		return err
+	}
+
+	ctxOrder := workflow.WithActivityOptions(ctx, activityOptions())
+	ctxOrder = workflow.WithTaskQueue(ctxOrder, OrderTaskQueue)
+
+	err = workflow.ExecuteActivity(ctxOrder, "CompleteOrder", params.OrderID).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to complete order", "OrderID", params.OrderID, "Error", err)
+		return err
+	}
+
+	logger.Info("Order completed", "OrderID", params.OrderID)
+	return nil
+}
@@ -1,23 +1,44 @@
 package workflows
 
 import (
+	"errors"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+
+	"orderservice/pkg/order/domain/model"
 )
 
 const (
+	OrderTaskQueue        = "orderservice_task_queue"
 	ProductTaskQueue      = "productservice_task_queue"
 	PaymentTaskQueue      = "paymentservice_task_queue"
 	NotificationTaskQueue = "notificationservice_task_queue"
 )
 
+const (
+	// BalanceToppedUpSignal шлет paymentservice, когда у пользователя с ожидающей оплатой вырос баланс
+	BalanceToppedUpSignal = "balance_topped_up"
+	// InsufficientFundsErrorType - тип ApplicationError, которым AuthorizePayment сообщает о нехватке денег
+	InsufficientFundsErrorType = "InsufficientFunds"
+)
+
 type CreateOrderParams struct {
 	OrderID    string
 	UserID     string
 	Items      []OrderItem
 	TotalPrice int64
+	// PaymentWindow - сколько ждать пополнения баланса, если денег не хватило. Ноль - отменять сразу
+	PaymentWindow time.Duration
+	// HoldTTL - на сколько paymentservice откладывает деньги под заказ
+	HoldTTL time.Duration
+	// SkipPaymentWindow - заказ не ждет пополнения независимо от настроек, так работают регулярные заказы
+	SkipPaymentWindow bool
+	// ApprovalThreshold - заказ дороже порога ждет ручного одобрения перед оплатой. Ноль - без одобрения
+	ApprovalThreshold int64
+	// ApprovalTimeout - сколько ждать решения, потом заказ отменяется. Ноль - ждать без ограничения
+	ApprovalTimeout time.Duration
 }
 
 type OrderItem struct {
@@ -25,52 +46,316 @@ type OrderItem struct {
 	Quantity  int
 }
 
+func CreateOrderWorkflowID(orderID string) string {
+	return "order_" + orderID
+}
+
 func CreateOrderWorkflow(ctx workflow.Context, params CreateOrderParams) error {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting CreateOrderWorkflow", "OrderID", params.OrderID)
 
-	options := workflow.ActivityOptions{
-		StartToCloseTimeout: time.Minute,
-		RetryPolicy: &temporal.RetryPolicy{
-			MaximumAttempts: 3,
-		},
+	tracker, err := newProgressTracker(ctx)
+	if err != nil {
+		return err
 	}
 
-	// 1. Reserve Products
+	options := activityOptions()
+
+	// клиент может отменить заказ, пока он в работе: проверяем сигнал между шагами
+	cancelChannel := workflow.GetSignalChannel(ctx, CancelOrderSignal)
+	cancelOnRequest := func(request CancelOrderRequest) {
+		logger.Info("Cancellation requested", "OrderID", params.OrderID)
+		err := compensateCancellation(ctx, tracker, CancelOrderParams{
+			OrderID:    params.OrderID,
+			UserID:     params.UserID,
+			TotalPrice: params.TotalPrice,
+			Reason:     request.Reason,
+			Actor:      request.Actor,
+		})
+		if err != nil {
+			logger.Error("Failed to cancel order on request", "Error", err)
+		}
+	}
+	cancelRequested := func() bool {
+		var request CancelOrderRequest
+		if !cancelChannel.ReceiveAsync(&request) {
+			return false
+		}
+		cancelOnRequest(request)
+		return true
+	}
+
+	ctxOrder := workflow.WithActivityOptions(ctx, options)
+	ctxOrder = workflow.WithTaskQueue(ctxOrder, OrderTaskQueue)
+
 	ctxProduct := workflow.WithActivityOptions(ctx, options)
 	ctxProduct = workflow.WithTaskQueue(ctxProduct, ProductTaskQueue)
 
-	var reserved bool
-	err := workflow.ExecuteActivity(ctxProduct, "ReserveProducts", params.Items).Get(ctxProduct, &reserved)
+	ctxPayment := workflow.WithActivityOptions(ctx, options)
+	ctxPayment = workflow.WithTaskQueue(ctxPayment, PaymentTaskQueue)
+
+	// 1. Authorize Payment: деньги откладываются до резерва и списываются после него,
+	// поэтому при сбое дальше возвращать ничего не нужно - холд просто отпускается
+	var authorized bool
+	err = tracker.run(ctxPayment, StepAuthorizing, "AuthorizePayment", &authorized, params.UserID, params.OrderID, params.TotalPrice, params.HoldTTL)
+
+	// 1a. Денег не хватило: ждем пополнения баланса до конца окна оплаты.
+	// Товары на время ожидания резервируются, а заказ уходит в StatusPaymentPending, как и до появления холдов
+	var reserved, reservedForWindow bool
+	if params.PaymentWindow > 0 && isInsufficientFunds(err) {
+		logger.Info("Insufficient funds, waiting for top up", "OrderID", params.OrderID, "Window", params.PaymentWindow)
+		reserveErr := tracker.run(ctxProduct, StepReserving, "ReserveProducts", &reserved, params.OrderID, params.Items)
+		if reserveErr != nil {
+			logger.Error("Failed to reserve products", "Error", reserveErr)
+			cancelOrder(ctxOrder, tracker, params.OrderID, failureReason("Failed to reserve products", reserveErr), model.ActorWorkflow)
+			return reserveErr
+		}
+		reservedForWindow = true
+		pendingErr := tracker.run(ctxOrder, StepAwaitingPayment, "SetOrderPaymentPending", nil, params.OrderID)
+		if pendingErr != nil {
+			logger.Error("Failed to set order payment pending, compensating...", "Error", pendingErr)
+			_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+			cancelOrder(ctxOrder, tracker, params.OrderID, failureReason("Failed to start payment", pendingErr), model.ActorWorkflow)
+			return pendingErr
+		}
+
+		topUpChannel := workflow.GetSignalChannel(ctx, BalanceToppedUpSignal)
+		deadline := workflow.Now(ctx).Add(params.PaymentWindow)
+
+		for isInsufficientFunds(err) {
+			remaining := deadline.Sub(workflow.Now(ctx))
+			if remaining <= 0 {
+				break
+			}
+			// ожидание пополнения заводится только на открытое окно: сигнал о пополнении забирает его,
+			// поэтому после каждой неудачной попытки заводим заново. Закрытое окно его снимает через VoidPayment
+			awaitErr := tracker.run(ctxPayment, StepAwaitingPayment, "AwaitBalanceTopUp", nil, params.UserID, params.OrderID, params.TotalPrice)
+			if awaitErr != nil {
+				err = awaitErr
+				break
+			}
+			tracker.enter(StepAwaitingPayment)
+
+			timerCtx, cancelTimer := workflow.WithCancel(ctx)
+			var toppedUp bool
+			var cancelRequest *CancelOrderRequest
+
+			selector := workflow.NewSelector(ctx)
+			selector.AddFuture(workflow.NewTimer(timerCtx, remaining), func(workflow.Future) {})
+			selector.AddReceive(topUpChannel, func(c workflow.ReceiveChannel, _ bool) {
+				c.Receive(ctx, nil)
+				toppedUp = true
+			})
+			selector.AddReceive(cancelChannel, func(c workflow.ReceiveChannel, _ bool) {
+				var request CancelOrderRequest
+				c.Receive(ctx, &request)
+				cancelRequest = &request
+			})
+			selector.Select(ctx)
+			cancelTimer()
+
+			if cancelRequest != nil {
+				cancelOnRequest(*cancelRequest)
+				return nil
+			}
+			if !toppedUp {
+				break
+			}
+
+			logger.Info("Balance topped up, retrying authorization", "OrderID", params.OrderID)
+			err = tracker.run(ctxPayment, StepAuthorizing, "AuthorizePayment", &authorized, params.UserID, params.OrderID, params.TotalPrice, params.HoldTTL)
+		}
+
+		if isInsufficientFunds(err) {
+			logger.Info("Payment window expired, cancelling order", "OrderID", params.OrderID)
+			_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+			// холда нет, но paymentservice снимет ожидание пополнения
+			voidPayment(ctxPayment, tracker, params.OrderID)
+			cancelOrder(ctxOrder, tracker, params.OrderID, "Payment window expired", model.ActorWorkflow)
+			return err
+		}
+	}
+
 	if err != nil {
-		logger.Error("Failed to reserve products", "Error", err)
+		logger.Error("Payment authorization failed, compensating...", "Error", err)
+		if reservedForWindow {
+			_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+		}
+
+		reason := failureReason("Payment failed", err)
+		// без окна оплаты нехватка денег - отдельная причина: по ней регулярный заказ пропускает запуск
+		if isInsufficientFunds(err) {
+			reason = model.CancelReasonInsufficientFunds
+		} else {
+			// холд мог встать, даже если ответ activity потерялся
+			voidPayment(ctxPayment, tracker, params.OrderID)
+		}
+		cancelOrder(ctxOrder, tracker, params.OrderID, reason, model.ActorWorkflow)
 		return err
 	}
+	if cancelRequested() {
+		return nil
+	}
 
-	// 2. Process Payment
-	ctxPayment := workflow.WithActivityOptions(ctx, options)
-	ctxPayment = workflow.WithTaskQueue(ctxPayment, PaymentTaskQueue)
+	// 2. Reserve Products, если товары не зарезервировали на время окна оплаты
+	if !reservedForWindow {
+		err = tracker.run(ctxProduct, StepReserving, "ReserveProducts", &reserved, params.OrderID, params.Items)
+		if err != nil {
+			logger.Error("Failed to reserve products", "Error", err)
+			voidPayment(ctxPayment, tracker, params.OrderID)
+			cancelOrder(ctxOrder, tracker, params.OrderID, failureReason("Failed to reserve products", err), model.ActorWorkflow)
+			return err
+		}
+		if cancelRequested() {
+			return nil
+		}
+	}
+
+	// 2a. Крупный заказ ждет ручного одобрения, резерв и холд на это время держим
+	if params.ApprovalThreshold > 0 && params.TotalPrice > params.ApprovalThreshold {
+		err = tracker.run(ctxOrder, StepAwaitingApproval, "SetOrderAwaitingApproval", nil, params.OrderID)
+		if err != nil {
+			logger.Error("Failed to set order awaiting approval, compensating...", "Error", err)
+			_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+			voidPayment(ctxPayment, tracker, params.OrderID)
+			cancelOrder(ctxOrder, tracker, params.OrderID, failureReason("Failed to request approval", err), model.ActorWorkflow)
+			return err
+		}
+
+		logger.Info("Waiting for approval", "OrderID", params.OrderID, "Timeout", params.ApprovalTimeout)
+		tracker.enter(StepAwaitingApproval)
+		decision, rejectRequest, cancelRequest := awaitApproval(ctx, cancelChannel, params.ApprovalTimeout)
+		switch decision {
+		case approvalCancelled:
+			cancelOnRequest(cancelRequest)
+			return nil
+		case approvalRejected, approvalTimedOut:
+			cancelReason := "Order rejected"
+			actor := model.ActorWorkflow
+			if decision == approvalTimedOut {
+				cancelReason = "Approval timed out"
+			} else {
+				if rejectRequest.Reason != "" {
+					cancelReason += ": " + rejectRequest.Reason
+				}
+				// сигналы, отправленные до появления actor, слал только администратор
+				actor = model.ActorAdmin
+				if rejectRequest.Actor != "" {
+					actor = rejectRequest.Actor
+				}
+			}
+			logger.Info("Order was not approved, cancelling", "OrderID", params.OrderID, "Reason", cancelReason)
+			_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+			voidPayment(ctxPayment, tracker, params.OrderID)
+			cancelOrder(ctxOrder, tracker, params.OrderID, cancelReason, actor)
+			return nil
+		}
+		logger.Info("Order approved", "OrderID", params.OrderID)
+	}
+
+	// 3. Capture Payment
+	err = tracker.run(ctxOrder, StepCharging, "SetOrderPaymentPending", nil, params.OrderID)
+	if err != nil {
+		logger.Error("Failed to set order payment pending, compensating...", "Error", err)
+		_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+		voidPayment(ctxPayment, tracker, params.OrderID)
+		cancelOrder(ctxOrder, tracker, params.OrderID, failureReason("Failed to start payment", err), model.ActorWorkflow)
+		return err
+	}
+	if cancelRequested() {
+		return nil
+	}
 
 	var paid bool
-	err = workflow.ExecuteActivity(ctxPayment, "ProcessPayment", params.UserID, params.TotalPrice).Get(ctxPayment, &paid)
+	err = tracker.run(ctxPayment, StepCharging, "CapturePayment", &paid, params.OrderID)
 	if err != nil {
-		logger.Error("Payment failed, compensating...", "Error", err)
+		logger.Error("Payment capture failed, compensating...", "Error", err)
+
+		// списание могло пройти, даже если ответ activity потерялся: возврат ничего не сделает, если денег не списали
+		_ = tracker.run(ctxPayment, StepCompensating, "RefundPayment", nil, params.UserID, params.OrderID, params.TotalPrice)
+		voidPayment(ctxPayment, tracker, params.OrderID)
+		_ = tracker.run(ctxProduct, StepCompensating, "ReleaseProducts", nil, params.OrderID)
+		cancelOrder(ctxOrder, tracker, params.OrderID, failureReason("Payment failed", err), model.ActorWorkflow)
+		return err
+	}
+	if cancelRequested() {
+		return nil
+	}
 
-		// Compensation: Release Products
-		_ = workflow.ExecuteActivity(ctxProduct, "ReleaseProducts", params.Items).Get(ctxProduct, nil)
+	err = tracker.run(ctxOrder, StepCharging, "MarkOrderPaid", nil, params.OrderID)
+	if err != nil {
+		logger.Error("Failed to mark order as paid, compensating...", "Error", err)
+		// деньги уже списаны: возвращаем их, как при отмене. Заказ мог успеть стать оплаченным,
+		// поэтому отменяем через CancelRefundedOrder, который отменяет и оплаченный
+		compensateErr := compensateCancellation(ctx, tracker, CancelOrderParams{
+			OrderID:    params.OrderID,
+			UserID:     params.UserID,
+			TotalPrice: params.TotalPrice,
+			Reason:     failureReason("Failed to mark order as paid", err),
+			Actor:      model.ActorWorkflow,
+		})
+		if compensateErr != nil {
+			logger.Error("Failed to compensate unpaid order", "OrderID", params.OrderID, "Error", compensateErr)
+		}
 		return err
 	}
 
-	// 3. Send Notification
+	// 4. Send Notification
 	ctxNotify := workflow.WithActivityOptions(ctx, options)
 	ctxNotify = workflow.WithTaskQueue(ctxNotify, NotificationTaskQueue)
 
-	err = workflow.ExecuteActivity(ctxNotify, "SendOrderCreatedNotification", params.UserID, params.OrderID).Get(ctxNotify, nil)
+	err = tracker.run(ctxNotify, StepNotifying, "SendOrderCreatedNotification", nil, params.UserID, params.OrderID)
 	if err != nil {
 		logger.Error("Failed to send notification", "Error", err)
 		// Notification failure shouldn't rollback order, just log
 	}
 
+	// сигнал мог прийти, пока выполнялись последние шаги
+	if cancelRequested() {
+		return nil
+	}
+
+	tracker.complete()
 	logger.Info("Order created successfully", "OrderID", params.OrderID)
 	return nil
 }
+
+func activityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	}
+}
+
+// cancelOrder отменяет неоплаченный заказ. actor - кто стал причиной отмены, пишется в историю статусов
+func cancelOrder(ctx workflow.Context, tracker *progressTracker, orderID, reason string, actor model.Actor) {
+	err := tracker.run(ctx, StepCompensating, "CancelOrder", nil, orderID, reason, actor)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("Failed to cancel order", "OrderID", orderID, "Error", err)
+	}
+}
+
+// voidPayment отпускает холд заказа. Без холда или по уже списанному холду paymentservice ничего не делает
+func voidPayment(ctx workflow.Context, tracker *progressTracker, orderID string) {
+	err := tracker.run(ctx, StepCompensating, "VoidPayment", nil, orderID)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("Failed to void payment", "OrderID", orderID, "Error", err)
+	}
+}
+
+// isInsufficientFunds проверяет тип ошибки, который paymentservice проставляет при нехватке денег
+func isInsufficientFunds(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == InsufficientFundsErrorType
+}
+
+// failureReason unwraps the activity error so the reason doesn't carry Temporal's error decoration
+func failureReason(prefix string, err error) string {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		return prefix + ": " + appErr.Message()
+	}
+	return prefix + ": " + err.Error()
+}
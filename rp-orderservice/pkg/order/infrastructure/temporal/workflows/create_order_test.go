@@ -0,0 +1,265 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	"orderservice/pkg/order/domain/model"
+)
+
+// Заглушки activity других сервисов: в тестах нужны только их имена и сигнатуры, поведение задают моки
+func stubAuthorizePayment(context.Context, string, string, int64, time.Duration) (bool, error) {
+	return true, nil
+}
+func stubAwaitBalanceTopUp(context.Context, string, string, int64) (bool, error) { return false, nil }
+func stubCapturePayment(context.Context, string) (bool, error)                   { return true, nil }
+func stubVoidPayment(context.Context, string) error                              { return nil }
+func stubRefundPayment(context.Context, string, string, int64) error             { return nil }
+func stubReserveProducts(context.Context, string, []OrderItem) (bool, error)     { return true, nil }
+func stubReleaseProducts(context.Context, string) error                          { return nil }
+func stubOrderActivity(context.Context, string) error                            { return nil }
+func stubCancelOrder(context.Context, string, string, model.Actor) error         { return nil }
+func stubNotification(context.Context, string, string) error                     { return nil }
+
+func newCreateOrderEnv(s *testsuite.WorkflowTestSuite) *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	register := func(fn interface{}, name string) {
+		env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+	}
+	register(stubAuthorizePayment, "AuthorizePayment")
+	register(stubAwaitBalanceTopUp, "AwaitBalanceTopUp")
+	register(stubCapturePayment, "CapturePayment")
+	register(stubVoidPayment, "VoidPayment")
+	register(stubRefundPayment, "RefundPayment")
+	register(stubReserveProducts, "ReserveProducts")
+	register(stubReleaseProducts, "ReleaseProducts")
+	register(stubOrderActivity, "SetOrderPaymentPending")
+	register(stubOrderActivity, "SetOrderAwaitingApproval")
+	register(stubOrderActivity, "MarkOrderPaid")
+	register(stubCancelOrder, "CancelOrder")
+	register(stubCancelOrder, "CancelRefundedOrder")
+	register(stubNotification, "SendOrderCreatedNotification")
+	return env
+}
+
+// mockActivities задает успешные ответы всех activity саги. Моки, заданные тестом раньше, срабатывают первыми
+func mockActivities(env *testsuite.TestWorkflowEnvironment) {
+	env.OnActivity("AuthorizePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	env.OnActivity("AwaitBalanceTopUp", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	env.OnActivity("CapturePayment", mock.Anything, mock.Anything).Return(true, nil)
+	env.OnActivity("VoidPayment", mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("RefundPayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("ReserveProducts", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	env.OnActivity("ReleaseProducts", mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("SetOrderPaymentPending", mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("SetOrderAwaitingApproval", mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("MarkOrderPaid", mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("CancelOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("CancelRefundedOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity("SendOrderCreatedNotification", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+}
+
+func TestCreateOrderWorkflow(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	const orderID = "order-1"
+	const userID = "user-1"
+
+	params := CreateOrderParams{
+		OrderID:    orderID,
+		UserID:     userID,
+		Items:      []OrderItem{{ProductID: "product-1", Quantity: 2}},
+		TotalPrice: 1000,
+		HoldTTL:    time.Hour,
+	}
+	insufficientFunds := temporal.NewNonRetryableApplicationError("insufficient funds", InsufficientFundsErrorType, nil)
+	failure := func(message string) error {
+		return temporal.NewNonRetryableApplicationError(message, "Failure", nil)
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.True(t, env.IsWorkflowCompleted())
+		assert.NoError(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "AuthorizePayment", mock.Anything, userID, orderID, params.TotalPrice, time.Hour)
+		env.AssertActivityCalled(t, "CapturePayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "MarkOrderPaid", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "SendOrderCreatedNotification", mock.Anything, userID, orderID)
+		env.AssertActivityNotCalled(t, "VoidPayment", mock.Anything, mock.Anything)
+		env.AssertActivityNotCalled(t, "CancelOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("authorization failed", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("AuthorizePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, failure("account is blocked"))
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.Error(t, env.GetWorkflowError())
+		// холд мог встать, даже если ответ потерялся
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, "Payment failed: account is blocked", model.ActorWorkflow)
+		env.AssertActivityNotCalled(t, "ReserveProducts", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("insufficient funds without payment window", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("AuthorizePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, insufficientFunds)
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.Error(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, model.CancelReasonInsufficientFunds, model.ActorWorkflow)
+		env.AssertActivityNotCalled(t, "VoidPayment", mock.Anything, mock.Anything)
+		env.AssertActivityNotCalled(t, "AwaitBalanceTopUp", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reservation failed", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("ReserveProducts", mock.Anything, mock.Anything, mock.Anything).
+			Return(false, failure("insufficient stock"))
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.Error(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, "Failed to reserve products: insufficient stock", model.ActorWorkflow)
+		env.AssertActivityNotCalled(t, "CapturePayment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("capture failed", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("CapturePayment", mock.Anything, mock.Anything).Return(false, failure("hold expired"))
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.Error(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "RefundPayment", mock.Anything, userID, orderID, params.TotalPrice)
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "ReleaseProducts", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, "Payment failed: hold expired", model.ActorWorkflow)
+		env.AssertActivityNotCalled(t, "MarkOrderPaid", mock.Anything, mock.Anything)
+	})
+
+	t.Run("mark paid failed after capture", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("MarkOrderPaid", mock.Anything, mock.Anything).Return(failure("database unavailable"))
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.Error(t, env.GetWorkflowError())
+		// деньги уже списаны - их нужно вернуть, а резерв снять
+		env.AssertActivityCalled(t, "RefundPayment", mock.Anything, userID, orderID, params.TotalPrice)
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "ReleaseProducts", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelRefundedOrder", mock.Anything, orderID, "Failed to mark order as paid: database unavailable", model.ActorWorkflow)
+		env.AssertActivityNotCalled(t, "SendOrderCreatedNotification", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("payment window expired", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("AuthorizePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, insufficientFunds)
+		mockActivities(env)
+
+		windowParams := params
+		windowParams.PaymentWindow = time.Hour
+		env.ExecuteWorkflow(CreateOrderWorkflow, windowParams)
+
+		assert.Error(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "SetOrderPaymentPending", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "AwaitBalanceTopUp", mock.Anything, userID, orderID, params.TotalPrice)
+		env.AssertActivityCalled(t, "ReleaseProducts", mock.Anything, orderID)
+		// холда нет, VoidPayment снимает ожидание пополнения
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, "Payment window expired", model.ActorWorkflow)
+	})
+
+	t.Run("balance topped up within payment window", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("AuthorizePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, insufficientFunds).Once()
+		mockActivities(env)
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(BalanceToppedUpSignal, nil)
+		}, time.Minute)
+
+		windowParams := params
+		windowParams.PaymentWindow = time.Hour
+		env.ExecuteWorkflow(CreateOrderWorkflow, windowParams)
+
+		assert.NoError(t, env.GetWorkflowError())
+		env.AssertActivityNumberOfCalls(t, "AuthorizePayment", 2)
+		// товары зарезервированы на время окна, второй раз их не резервируем
+		env.AssertActivityNumberOfCalls(t, "ReserveProducts", 1)
+		env.AssertActivityCalled(t, "MarkOrderPaid", mock.Anything, orderID)
+		env.AssertActivityNotCalled(t, "CancelOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	approvalParams := params
+	approvalParams.ApprovalThreshold = 500
+	approvalParams.ApprovalTimeout = time.Hour
+
+	t.Run("approval rejected", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		mockActivities(env)
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(RejectOrderSignal, RejectOrderRequest{Reason: "suspicious", Actor: model.ActorAdmin})
+		}, time.Minute)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, approvalParams)
+
+		assert.NoError(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "SetOrderAwaitingApproval", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "ReleaseProducts", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, "Order rejected: suspicious", model.ActorAdmin)
+		env.AssertActivityNotCalled(t, "CapturePayment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("approval timed out", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, approvalParams)
+
+		assert.NoError(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "ReleaseProducts", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelOrder", mock.Anything, orderID, "Approval timed out", model.ActorWorkflow)
+	})
+
+	t.Run("cancelled by customer while awaiting approval", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		mockActivities(env)
+		env.RegisterDelayedCallback(func() {
+			env.SignalWorkflow(CancelOrderSignal, CancelOrderRequest{Reason: "changed my mind", Actor: model.ActorCustomer})
+		}, time.Minute)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, approvalParams)
+
+		assert.NoError(t, env.GetWorkflowError())
+		env.AssertActivityCalled(t, "RefundPayment", mock.Anything, userID, orderID, params.TotalPrice)
+		env.AssertActivityCalled(t, "VoidPayment", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "ReleaseProducts", mock.Anything, orderID)
+		env.AssertActivityCalled(t, "CancelRefundedOrder", mock.Anything, orderID, "changed my mind", model.ActorCustomer)
+		env.AssertActivityNotCalled(t, "CapturePayment", mock.Anything, mock.Anything)
+	})
+}
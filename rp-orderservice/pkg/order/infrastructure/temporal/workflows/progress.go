@@ -0,0 +1,69 @@
+package workflows
+
+import (
+	"go.temporal.io/sdk/workflow"
+)
+
+// OrderProgressQuery - query-обработчик CreateOrderWorkflow, отдающий текущий шаг саги
+const OrderProgressQuery = "order_progress"
+
+const (
+	StepAuthorizing      = "authorizing"
+	StepReserving        = "reserving"
+	StepAwaitingApproval = "awaiting_approval"
+	StepCharging         = "charging"
+	StepAwaitingPayment  = "awaiting_payment"
+	StepNotifying        = "notifying"
+	StepCompensating     = "compensating"
+	StepCompleted        = "completed"
+)
+
+type OrderProgress struct {
+	Step string
+	// Activity - activity, которую шаг выполняет сейчас. Пусто, если workflow ждет сигнала или таймера
+	Activity  string
+	LastError string
+	// Attempts - сколько activity запускалось на каждом шаге. Повторы делает Temporal по RetryPolicy,
+	// workflow их не видит: попытки выполняющейся activity добавляет тот, кто читает прогресс
+	Attempts map[string]int
+}
+
+type progressTracker struct {
+	progress OrderProgress
+}
+
+func newProgressTracker(ctx workflow.Context) (*progressTracker, error) {
+	t := &progressTracker{
+		progress: OrderProgress{
+			Step:     StepAuthorizing,
+			Attempts: map[string]int{},
+		},
+	}
+	err := workflow.SetQueryHandler(ctx, OrderProgressQuery, func() (OrderProgress, error) {
+		return t.progress, nil
+	})
+	return t, err
+}
+
+// run выполняет activity в рамках шага и запоминает ошибку, если она случилась
+func (t *progressTracker) run(ctx workflow.Context, step, activity string, result interface{}, args ...interface{}) error {
+	t.progress.Step = step
+	t.progress.Activity = activity
+	t.progress.Attempts[step]++
+	defer func() { t.progress.Activity = "" }()
+
+	err := workflow.ExecuteActivity(ctx, activity, args...).Get(ctx, result)
+	if err != nil {
+		t.progress.LastError = err.Error()
+	}
+	return err
+}
+
+// enter переключает шаг, на котором workflow ждет, а не выполняет activity
+func (t *progressTracker) enter(step string) {
+	t.progress.Step = step
+}
+
+func (t *progressTracker) complete() {
+	t.progress.Step = StepCompleted
+}
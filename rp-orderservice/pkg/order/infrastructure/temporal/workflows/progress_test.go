@@ -0,0 +1,87 @@
+package workflows
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestCreateOrderWorkflow_ProgressQuery(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	params := CreateOrderParams{
+		OrderID:    "order-1",
+		UserID:     "user-1",
+		Items:      []OrderItem{{ProductID: "product-1", Quantity: 1}},
+		TotalPrice: 1000,
+		HoldTTL:    time.Hour,
+	}
+
+	queryProgress := func(t *testing.T, env *testsuite.TestWorkflowEnvironment) OrderProgress {
+		value, err := env.QueryWorkflow(OrderProgressQuery)
+		assert.NoError(t, err)
+		var progress OrderProgress
+		assert.NoError(t, value.Get(&progress))
+		return progress
+	}
+
+	t.Run("reports the step the workflow waits on", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		mockActivities(env)
+
+		var waiting OrderProgress
+		env.RegisterDelayedCallback(func() {
+			waiting = queryProgress(t, env)
+			env.SignalWorkflow(ApproveOrderSignal, nil)
+		}, time.Minute)
+
+		approvalParams := params
+		approvalParams.ApprovalThreshold = 500
+		env.ExecuteWorkflow(CreateOrderWorkflow, approvalParams)
+
+		assert.NoError(t, env.GetWorkflowError())
+		assert.Equal(t, StepAwaitingApproval, waiting.Step)
+		assert.Empty(t, waiting.Activity)
+		assert.Equal(t, map[string]int{StepAuthorizing: 1, StepReserving: 1, StepAwaitingApproval: 1}, waiting.Attempts)
+
+		progress := queryProgress(t, env)
+		assert.Equal(t, StepCompleted, progress.Step)
+		assert.Empty(t, progress.LastError)
+	})
+
+	t.Run("leaves retries to the server retry policy", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("AuthorizePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, errors.New("payment service unavailable")).Once()
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.NoError(t, env.GetWorkflowError())
+		// повтор сделал Temporal, workflow запускал activity один раз
+		env.AssertActivityNumberOfCalls(t, "AuthorizePayment", 2)
+		progress := queryProgress(t, env)
+		assert.Equal(t, 1, progress.Attempts[StepAuthorizing])
+		assert.Empty(t, progress.LastError)
+	})
+
+	t.Run("records the error of a failed step", func(t *testing.T) {
+		env := newCreateOrderEnv(&suite)
+		env.OnActivity("CapturePayment", mock.Anything, mock.Anything).
+			Return(false, temporal.NewNonRetryableApplicationError("hold expired", "Failure", nil))
+		mockActivities(env)
+
+		env.ExecuteWorkflow(CreateOrderWorkflow, params)
+
+		assert.Error(t, env.GetWorkflowError())
+		progress := queryProgress(t, env)
+		assert.Equal(t, StepCompensating, progress.Step)
+		assert.Contains(t, progress.LastError, "hold expired")
+		// SetOrderPaymentPending и CapturePayment
+		assert.Equal(t, 2, progress.Attempts[StepCharging])
+	})
+}
@@ -0,0 +1,57 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/workflow"
+)
+
+// RecurringOrderScheduleParams - состояние, к которому приводится расписание регулярного заказа
+type RecurringOrderScheduleParams struct {
+	RecurringOrderID string
+	Interval         time.Duration
+	Paused           bool
+	Cancelled        bool
+}
+
+type RecurringOrderParams struct {
+	RecurringOrderID string
+}
+
+func RecurringOrderScheduleID(recurringOrderID string) string {
+	return "recurring_order_" + recurringOrderID
+}
+
+// RecurringOrderWorkflowID - префикс ID запусков, расписание добавляет к нему время запуска
+func RecurringOrderWorkflowID(recurringOrderID string) string {
+	return "recurring_order_run_" + recurringOrderID
+}
+
+// RecurringOrderWorkflow запускается расписанием и создает очередной заказ.
+// ID запуска фиксируется в истории workflow, поэтому повтор activity не создаст второй заказ
+func RecurringOrderWorkflow(ctx workflow.Context, params RecurringOrderParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting RecurringOrderWorkflow", "RecurringOrderID", params.RecurringOrderID)
+
+	var runID string
+	err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+		return uuid.Must(uuid.NewV7()).String()
+	}).Get(&runID)
+	if err != nil {
+		return err
+	}
+
+	// ошибки создания заказа activity записывает в запуск сама, повторяются только сбои инфраструктуры
+	ctxOrder := workflow.WithActivityOptions(ctx, activityOptions())
+	ctxOrder = workflow.WithTaskQueue(ctxOrder, OrderTaskQueue)
+
+	err = workflow.ExecuteActivity(ctxOrder, "PlaceRecurringOrder", params.RecurringOrderID, runID).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to place recurring order", "RecurringOrderID", params.RecurringOrderID, "Error", err)
+		return err
+	}
+
+	logger.Info("Recurring order placed", "RecurringOrderID", params.RecurringOrderID, "RunID", runID)
+	return nil
+}
@@ -0,0 +1,72 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type ReturnOrderParams struct {
+	ReturnID     string
+	OrderID      string
+	UserID       string
+	Items        []OrderItem
+	RefundAmount int64
+}
+
+func ReturnOrderWorkflowID(returnID string) string {
+	return "order_return_" + returnID
+}
+
+// ReturnOrderWorkflow возвращает товары одобренного возврата на склад и деньги клиенту.
+// Все шаги идемпотентны по возврату, поэтому повтор после сбоя ничего не задвоит
+func ReturnOrderWorkflow(ctx workflow.Context, params ReturnOrderParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting ReturnOrderWorkflow", "ReturnID", params.ReturnID, "OrderID", params.OrderID)
+
+	options := returnActivityOptions()
+
+	ctxProduct := workflow.WithActivityOptions(ctx, options)
+	ctxProduct = workflow.WithTaskQueue(ctxProduct, ProductTaskQueue)
+
+	err := workflow.ExecuteActivity(ctxProduct, "RestockProducts", params.ReturnID, params.Items).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to restock products", "ReturnID", params.ReturnID, "Error", err)
+		return err
+	}
+
+	ctxPayment := workflow.WithActivityOptions(ctx, options)
+	ctxPayment = workflow.WithTaskQueue(ctxPayment, PaymentTaskQueue)
+
+	err = workflow.ExecuteActivity(ctxPayment, "RefundReturn", params.UserID, params.OrderID, params.ReturnID, params.RefundAmount).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to refund return", "ReturnID", params.ReturnID, "Error", err)
+		return err
+	}
+
+	ctxOrder := workflow.WithActivityOptions(ctx, options)
+	ctxOrder = workflow.WithTaskQueue(ctxOrder, OrderTaskQueue)
+
+	err = workflow.ExecuteActivity(ctxOrder, "CompleteReturn", params.ReturnID).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to complete return", "ReturnID", params.ReturnID, "Error", err)
+		return err
+	}
+
+	logger.Info("Return completed", "ReturnID", params.ReturnID)
+	return nil
+}
+
+// returnActivityOptions повторяет шаги до успеха: откатить склад или возврат денег нечем,
+// а брошенный на полпути возврат оставит товар на складе без денег у клиента
+func returnActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumInterval: 10 * time.Minute,
+			// ноль - без ограничения числа попыток
+			MaximumAttempts: 0,
+		},
+	}
+}
@@ -2,29 +2,57 @@ package transport
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"orderservice/api/server/orderinternal"
 	appmodel "orderservice/pkg/order/application/model"
 	"orderservice/pkg/order/application/query"
 	"orderservice/pkg/order/application/service"
+	"orderservice/pkg/order/domain/model"
 )
 
 func NewOrderInternalAPI(
 	orderQueryService query.OrderQueryService,
+	cartQueryService query.CartQueryService,
+	returnQueryService query.ReturnQueryService,
+	reportingQueryService query.ReportingQueryService,
+	recurringOrderQueryService query.RecurringOrderQueryService,
 	orderService service.OrderService,
+	cartService service.CartService,
+	returnService service.ReturnService,
+	promoService service.PromoService,
+	recurringOrderService service.RecurringOrderService,
 ) orderinternal.OrderInternalServiceServer {
 	return &orderInternalAPI{
-		orderQueryService: orderQueryService,
-		orderService:      orderService,
+		orderQueryService:          orderQueryService,
+		cartQueryService:           cartQueryService,
+		returnQueryService:         returnQueryService,
+		reportingQueryService:      reportingQueryService,
+		recurringOrderQueryService: recurringOrderQueryService,
+		orderService:               orderService,
+		cartService:                cartService,
+		returnService:              returnService,
+		promoService:               promoService,
+		recurringOrderService:      recurringOrderService,
 	}
 }
 
 type orderInternalAPI struct {
-	orderQueryService query.OrderQueryService
-	orderService      service.OrderService
+	orderQueryService          query.OrderQueryService
+	cartQueryService           query.CartQueryService
+	returnQueryService         query.ReturnQueryService
+	reportingQueryService      query.ReportingQueryService
+	recurringOrderQueryService query.RecurringOrderQueryService
+	orderService               service.OrderService
+	cartService                service.CartService
+	returnService              service.ReturnService
+	promoService               service.PromoService
+	recurringOrderService      service.RecurringOrderService
 	orderinternal.UnimplementedOrderInternalServiceServer
 }
 
@@ -47,8 +75,11 @@ func (a *orderInternalAPI) CreateOrder(ctx context.Context, request *orderintern
 	}
 
 	orderID, err := a.orderService.CreateOrder(ctx, appmodel.CreateOrder{
-		UserID: userID,
-		Items:  items,
+		UserID:         userID,
+		Items:          items,
+		IdempotencyKey: request.IdempotencyKey,
+		PromoCode:      request.PromoCode,
+		QuoteToken:     request.QuoteToken,
 	})
 	if err != nil {
 		return nil, err
@@ -57,6 +88,100 @@ func (a *orderInternalAPI) CreateOrder(ctx context.Context, request *orderintern
 	return &orderinternal.CreateOrderResponse{OrderID: orderID.String()}, nil
 }
 
+func (a *orderInternalAPI) QuoteOrder(ctx context.Context, request *orderinternal.QuoteOrderRequest) (*orderinternal.QuoteOrderResponse, error) {
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid user id")
+	}
+
+	items := make([]appmodel.OrderItem, len(request.Items))
+	for i, item := range request.Items {
+		productID, err := uuid.Parse(item.ProductID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid product id: %s", item.ProductID)
+		}
+		items[i] = appmodel.OrderItem{
+			ProductID: productID,
+			Quantity:  int(item.Quantity),
+		}
+	}
+
+	quote, err := a.orderService.QuoteOrder(ctx, appmodel.QuoteOrder{
+		UserID:    userID,
+		Items:     items,
+		PromoCode: request.PromoCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	quotedItems := make([]*orderinternal.QuotedItem, len(quote.Items))
+	for i, item := range quote.Items {
+		quotedItems[i] = &orderinternal.QuotedItem{
+			ProductID: item.ProductID.String(),
+			Quantity:  int32(item.Quantity), // nolint:gosec
+			Price:     item.Price,
+			Discount:  item.Discount,
+		}
+	}
+
+	return &orderinternal.QuoteOrderResponse{
+		Quote: &orderinternal.Quote{
+			Items:      quotedItems,
+			Discount:   quote.Discount,
+			TotalPrice: quote.TotalPrice,
+			ExpiresAt:  quote.ExpiresAt,
+			Token:      quote.Token,
+		},
+	}, nil
+}
+
+func (a *orderInternalAPI) Reorder(ctx context.Context, request *orderinternal.ReorderRequest) (*orderinternal.ReorderResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	result, err := a.orderService.Reorder(ctx, appmodel.Reorder{
+		OrderID:        orderID,
+		Confirm:        request.Confirm,
+		QuoteToken:     request.QuoteToken,
+		IdempotencyKey: request.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &orderinternal.ReorderResponse{
+		Items:      make([]*orderinternal.ReorderedItem, len(result.Items)),
+		Dropped:    make([]*orderinternal.DroppedItem, len(result.Dropped)),
+		TotalPrice: result.TotalPrice,
+		QuoteToken: result.QuoteToken,
+		ExpiresAt:  result.ExpiresAt,
+	}
+	if result.OrderID != uuid.Nil {
+		newOrderID := result.OrderID.String()
+		response.OrderID = &newOrderID
+	}
+	for i, item := range result.Items {
+		response.Items[i] = &orderinternal.ReorderedItem{
+			ProductID:     item.ProductID.String(),
+			Quantity:      int32(item.Quantity), // nolint:gosec
+			PreviousPrice: item.PreviousPrice,
+			Price:         item.Price,
+			PriceChanged:  item.PriceChanged(),
+		}
+	}
+	for i, item := range result.Dropped {
+		response.Dropped[i] = &orderinternal.DroppedItem{
+			ProductID: item.ProductID.String(),
+			Quantity:  int32(item.Quantity),                  // nolint:gosec
+			Reason:    orderinternal.DropReason(item.Reason), // nolint:gosec
+		}
+	}
+	return response, nil
+}
+
 func (a *orderInternalAPI) FindOrder(ctx context.Context, request *orderinternal.FindOrderRequest) (*orderinternal.FindOrderResponse, error) {
 	orderID, err := uuid.Parse(request.OrderID)
 	if err != nil {
@@ -72,22 +197,630 @@ func (a *orderInternalAPI) FindOrder(ctx context.Context, request *orderinternal
 		return &orderinternal.FindOrderResponse{}, nil
 	}
 
-	items := make([]*orderinternal.OrderItem, len(order.Items))
-	for i, item := range order.Items {
-		items[i] = &orderinternal.OrderItem{
+	return &orderinternal.FindOrderResponse{
+		Order: toOrderProto(*order),
+	}, nil
+}
+
+func (a *orderInternalAPI) ListOrders(ctx context.Context, request *orderinternal.ListOrdersRequest) (*orderinternal.ListOrdersResponse, error) {
+	spec := appmodel.ListOrders{
+		MinTotalPrice: request.MinTotalPrice,
+		MaxTotalPrice: request.MaxTotalPrice,
+		PageSize:      int(request.PageSize),
+	}
+	if request.UserID != nil {
+		userID, err := uuid.Parse(*request.UserID)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid user id")
+		}
+		spec.UserID = &userID
+	}
+	for _, status := range request.Statuses {
+		spec.Statuses = append(spec.Statuses, int(status))
+	}
+	if request.CreatedFrom != nil {
+		createdFrom := time.Unix(*request.CreatedFrom, 0)
+		spec.CreatedFrom = &createdFrom
+	}
+	if request.CreatedTo != nil {
+		createdTo := time.Unix(*request.CreatedTo, 0)
+		spec.CreatedTo = &createdTo
+	}
+	if request.PageToken != "" {
+		afterID, err := uuid.Parse(request.PageToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid page token")
+		}
+		spec.AfterID = &afterID
+	}
+
+	page, err := a.orderQueryService.ListOrders(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &orderinternal.ListOrdersResponse{
+		Orders: make([]*orderinternal.Order, len(page.Orders)),
+	}
+	for i, order := range page.Orders {
+		response.Orders[i] = toOrderProto(order)
+	}
+	if page.NextAfterID != nil {
+		response.NextPageToken = page.NextAfterID.String()
+	}
+	return response, nil
+}
+
+func (a *orderInternalAPI) CancelOrder(ctx context.Context, request *orderinternal.CancelOrderRequest) (*orderinternal.CancelOrderResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	if request.ByAdmin {
+		err = a.orderService.RequestCancellation(ctx, orderID, uuid.Nil, request.Reason, model.ActorAdmin)
+	} else {
+		var userID uuid.UUID
+		userID, err = uuid.Parse(request.UserID)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid user id")
+		}
+		err = a.orderService.RequestCancellation(ctx, orderID, userID, request.Reason, model.ActorCustomer)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.CancelOrderResponse{}, nil
+}
+
+func (a *orderInternalAPI) ApproveOrder(ctx context.Context, request *orderinternal.ApproveOrderRequest) (*orderinternal.ApproveOrderResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	err = a.orderService.ApproveOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.ApproveOrderResponse{}, nil
+}
+
+func (a *orderInternalAPI) RejectOrder(ctx context.Context, request *orderinternal.RejectOrderRequest) (*orderinternal.RejectOrderResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	err = a.orderService.RejectOrder(ctx, orderID, request.Reason, model.ActorAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.RejectOrderResponse{}, nil
+}
+
+func (a *orderInternalAPI) ListAwaitingApproval(ctx context.Context, request *orderinternal.ListAwaitingApprovalRequest) (*orderinternal.ListAwaitingApprovalResponse, error) {
+	spec := appmodel.ListOrders{
+		Statuses: []int{int(orderinternal.OrderStatus_AWAITING_APPROVAL)},
+		PageSize: int(request.PageSize),
+	}
+	if request.PageToken != "" {
+		afterID, err := uuid.Parse(request.PageToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid page token")
+		}
+		spec.AfterID = &afterID
+	}
+
+	page, err := a.orderQueryService.ListOrders(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &orderinternal.ListAwaitingApprovalResponse{
+		Orders: make([]*orderinternal.Order, len(page.Orders)),
+	}
+	for i, order := range page.Orders {
+		response.Orders[i] = toOrderProto(order)
+	}
+	if page.NextAfterID != nil {
+		response.NextPageToken = page.NextAfterID.String()
+	}
+	return response, nil
+}
+
+func (a *orderInternalAPI) MarkShipped(ctx context.Context, request *orderinternal.MarkShippedRequest) (*orderinternal.MarkShippedResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	err = a.orderService.MarkShipped(ctx, orderID, request.Carrier, request.TrackingNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.MarkShippedResponse{}, nil
+}
+
+func (a *orderInternalAPI) MarkDelivered(ctx context.Context, request *orderinternal.MarkDeliveredRequest) (*orderinternal.MarkDeliveredResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	err = a.orderService.MarkDelivered(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.MarkDeliveredResponse{}, nil
+}
+
+func (a *orderInternalAPI) GetOrderProgress(ctx context.Context, request *orderinternal.GetOrderProgressRequest) (*orderinternal.GetOrderProgressResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	progress, err := a.orderService.GetOrderProgress(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make(map[string]int32, len(progress.Attempts))
+	for step, count := range progress.Attempts {
+		attempts[step] = int32(count) // nolint:gosec
+	}
+
+	return &orderinternal.GetOrderProgressResponse{
+		Progress: &orderinternal.OrderProgress{
+			OrderID:   progress.OrderID.String(),
+			Status:    orderinternal.OrderStatus(progress.Status), // nolint:gosec
+			Running:   progress.Running,
+			Step:      progress.Step,
+			LastError: progress.LastError,
+			Attempts:  attempts,
+		},
+	}, nil
+}
+
+func (a *orderInternalAPI) AddItem(ctx context.Context, request *orderinternal.AddItemRequest) (*orderinternal.AddItemResponse, error) {
+	userID, productID, err := parseCartItemIDs(request.UserID, request.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.cartService.AddItem(ctx, userID, productID, int(request.Quantity))
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.AddItemResponse{}, nil
+}
+
+func (a *orderInternalAPI) UpdateQuantity(ctx context.Context, request *orderinternal.UpdateQuantityRequest) (*orderinternal.UpdateQuantityResponse, error) {
+	userID, productID, err := parseCartItemIDs(request.UserID, request.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.cartService.UpdateQuantity(ctx, userID, productID, int(request.Quantity))
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.UpdateQuantityResponse{}, nil
+}
+
+func (a *orderInternalAPI) RemoveItem(ctx context.Context, request *orderinternal.RemoveItemRequest) (*orderinternal.RemoveItemResponse, error) {
+	userID, productID, err := parseCartItemIDs(request.UserID, request.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.cartService.RemoveItem(ctx, userID, productID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.RemoveItemResponse{}, nil
+}
+
+func (a *orderInternalAPI) GetCart(ctx context.Context, request *orderinternal.GetCartRequest) (*orderinternal.GetCartResponse, error) {
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid user id")
+	}
+
+	cart, err := a.cartQueryService.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*orderinternal.CartItem, len(cart.Items))
+	for i, item := range cart.Items {
+		items[i] = &orderinternal.CartItem{
 			ProductID: item.ProductID.String(),
+			Name:      item.Name,
 			Quantity:  int32(item.Quantity), // nolint:gosec
+			Price:     item.Price,
+			Available: item.Available,
 		}
 	}
 
-	return &orderinternal.FindOrderResponse{
-		Order: &orderinternal.Order{
-			OrderID:    order.OrderID.String(),
-			UserID:     order.UserID.String(),
+	return &orderinternal.GetCartResponse{
+		Cart: &orderinternal.Cart{
+			UserID:     cart.UserID.String(),
 			Items:      items,
-			TotalPrice: order.TotalPrice,
-			Status:     orderinternal.OrderStatus(order.Status), // nolint:gosec
-			CreatedAt:  order.CreatedAt,
+			TotalPrice: cart.TotalPrice,
+			UpdatedAt:  cart.UpdatedAt,
+		},
+	}, nil
+}
+
+func (a *orderInternalAPI) Checkout(ctx context.Context, request *orderinternal.CheckoutRequest) (*orderinternal.CheckoutResponse, error) {
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid user id")
+	}
+
+	orderID, err := a.cartService.Checkout(ctx, userID, request.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.CheckoutResponse{OrderID: orderID.String()}, nil
+}
+
+func (a *orderInternalAPI) RequestReturn(ctx context.Context, request *orderinternal.RequestReturnRequest) (*orderinternal.RequestReturnResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid order id")
+	}
+
+	items := make([]appmodel.OrderItem, len(request.Items))
+	for i, item := range request.Items {
+		productID, err := uuid.Parse(item.ProductID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid product id: %s", item.ProductID)
+		}
+		items[i] = appmodel.OrderItem{
+			ProductID: productID,
+			Quantity:  int(item.Quantity),
+		}
+	}
+
+	returnID, err := a.returnService.RequestReturn(ctx, orderID, items, request.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.RequestReturnResponse{ReturnID: returnID.String()}, nil
+}
+
+func (a *orderInternalAPI) ApproveReturn(ctx context.Context, request *orderinternal.ApproveReturnRequest) (*orderinternal.ApproveReturnResponse, error) {
+	returnID, err := uuid.Parse(request.ReturnID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid return id")
+	}
+
+	err = a.returnService.ApproveReturn(ctx, returnID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.ApproveReturnResponse{}, nil
+}
+
+func (a *orderInternalAPI) RejectReturn(ctx context.Context, request *orderinternal.RejectReturnRequest) (*orderinternal.RejectReturnResponse, error) {
+	returnID, err := uuid.Parse(request.ReturnID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid return id")
+	}
+
+	err = a.returnService.RejectReturn(ctx, returnID, request.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.RejectReturnResponse{}, nil
+}
+
+func (a *orderInternalAPI) FindReturn(ctx context.Context, request *orderinternal.FindReturnRequest) (*orderinternal.FindReturnResponse, error) {
+	returnID, err := uuid.Parse(request.ReturnID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid return id")
+	}
+
+	orderReturn, err := a.returnQueryService.FindReturn(ctx, returnID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*orderinternal.OrderItem, len(orderReturn.Items))
+	for i, item := range orderReturn.Items {
+		items[i] = &orderinternal.OrderItem{
+			ProductID: item.ProductID.String(),
+			Quantity:  int32(item.Quantity), // nolint:gosec
+		}
+	}
+
+	return &orderinternal.FindReturnResponse{
+		Return: &orderinternal.Return{
+			ReturnID:     orderReturn.ReturnID.String(),
+			OrderID:      orderReturn.OrderID.String(),
+			UserID:       orderReturn.UserID.String(),
+			Items:        items,
+			RefundAmount: orderReturn.RefundAmount,
+			Status:       orderinternal.ReturnStatus(orderReturn.Status), // nolint:gosec
+			Reason:       orderReturn.Reason,
+			CreatedAt:    orderReturn.CreatedAt,
+			UpdatedAt:    orderReturn.UpdatedAt,
 		},
 	}, nil
 }
+
+func (a *orderInternalAPI) CreatePromoCode(ctx context.Context, request *orderinternal.CreatePromoCodeRequest) (*orderinternal.CreatePromoCodeResponse, error) {
+	promo := appmodel.PromoCode{
+		Code:          request.Code,
+		Type:          int(request.Type),
+		Value:         request.Value,
+		MinOrderValue: request.MinOrderValue,
+		UsageLimit:    int(request.UsageLimit),
+		PerUserLimit:  int(request.PerUserLimit),
+	}
+	if request.ValidFrom != nil {
+		validFrom := time.Unix(*request.ValidFrom, 0)
+		promo.ValidFrom = &validFrom
+	}
+	if request.ValidTo != nil {
+		validTo := time.Unix(*request.ValidTo, 0)
+		promo.ValidTo = &validTo
+	}
+
+	err := a.promoService.CreatePromoCode(ctx, promo)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.CreatePromoCodeResponse{}, nil
+}
+
+func (a *orderInternalAPI) GetRevenue(ctx context.Context, request *orderinternal.GetRevenueRequest) (*orderinternal.GetRevenueResponse, error) {
+	period, err := toReportPeriod(request.Period)
+	if err != nil {
+		return nil, err
+	}
+	points, err := a.reportingQueryService.Revenue(ctx, appmodel.RevenueReport{
+		Period:      period,
+		Granularity: appmodel.ReportGranularity(request.Granularity),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*orderinternal.RevenuePoint, len(points))
+	for i, point := range points {
+		result[i] = &orderinternal.RevenuePoint{
+			PeriodStart:    point.PeriodStart,
+			PaidOrders:     int32(point.PaidOrders), // nolint:gosec
+			Revenue:        point.Revenue,
+			RefundedOrders: int32(point.RefundedOrders), // nolint:gosec
+			Refunds:        point.Refunds,
+		}
+	}
+	return &orderinternal.GetRevenueResponse{Points: result}, nil
+}
+
+func (a *orderInternalAPI) GetTopProducts(ctx context.Context, request *orderinternal.GetTopProductsRequest) (*orderinternal.GetTopProductsResponse, error) {
+	period, err := toReportPeriod(request.Period)
+	if err != nil {
+		return nil, err
+	}
+	products, err := a.reportingQueryService.TopProducts(ctx, appmodel.TopProductsReport{
+		Period:  period,
+		OrderBy: appmodel.ProductSalesOrder(request.OrderBy),
+		Limit:   int(request.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*orderinternal.ProductSales, len(products))
+	for i, product := range products {
+		result[i] = &orderinternal.ProductSales{
+			ProductID: product.ProductID.String(),
+			Quantity:  int32(product.Quantity), // nolint:gosec
+			Revenue:   product.Revenue,
+		}
+	}
+	return &orderinternal.GetTopProductsResponse{Products: result}, nil
+}
+
+func (a *orderInternalAPI) GetOrderStatusCounts(ctx context.Context, request *orderinternal.GetOrderStatusCountsRequest) (*orderinternal.GetOrderStatusCountsResponse, error) {
+	period, err := toReportPeriod(request.Period)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := a.reportingQueryService.OrderStatusCounts(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*orderinternal.StatusCount, len(counts))
+	for i, count := range counts {
+		result[i] = &orderinternal.StatusCount{
+			Status: orderinternal.OrderStatus(count.Status), // nolint:gosec
+			Orders: int32(count.Orders),                     // nolint:gosec
+		}
+	}
+	return &orderinternal.GetOrderStatusCountsResponse{Counts: result}, nil
+}
+
+func (a *orderInternalAPI) CreateRecurringOrder(ctx context.Context, request *orderinternal.CreateRecurringOrderRequest) (*orderinternal.CreateRecurringOrderResponse, error) {
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid user id")
+	}
+
+	items := make([]appmodel.OrderItem, len(request.Items))
+	for i, item := range request.Items {
+		productID, err := uuid.Parse(item.ProductID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid product id: %s", item.ProductID)
+		}
+		items[i] = appmodel.OrderItem{
+			ProductID: productID,
+			Quantity:  int(item.Quantity),
+		}
+	}
+
+	recurringOrderID, err := a.recurringOrderService.CreateRecurringOrder(ctx, appmodel.CreateRecurringOrder{
+		UserID:   userID,
+		Items:    items,
+		Interval: time.Duration(request.Interval) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.CreateRecurringOrderResponse{RecurringOrderID: recurringOrderID.String()}, nil
+}
+
+func (a *orderInternalAPI) PauseRecurringOrder(ctx context.Context, request *orderinternal.PauseRecurringOrderRequest) (*orderinternal.PauseRecurringOrderResponse, error) {
+	recurringOrderID, err := uuid.Parse(request.RecurringOrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recurring order id")
+	}
+
+	err = a.recurringOrderService.PauseRecurringOrder(ctx, recurringOrderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.PauseRecurringOrderResponse{}, nil
+}
+
+func (a *orderInternalAPI) ResumeRecurringOrder(ctx context.Context, request *orderinternal.ResumeRecurringOrderRequest) (*orderinternal.ResumeRecurringOrderResponse, error) {
+	recurringOrderID, err := uuid.Parse(request.RecurringOrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recurring order id")
+	}
+
+	err = a.recurringOrderService.ResumeRecurringOrder(ctx, recurringOrderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.ResumeRecurringOrderResponse{}, nil
+}
+
+func (a *orderInternalAPI) CancelRecurringOrder(ctx context.Context, request *orderinternal.CancelRecurringOrderRequest) (*orderinternal.CancelRecurringOrderResponse, error) {
+	recurringOrderID, err := uuid.Parse(request.RecurringOrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recurring order id")
+	}
+
+	err = a.recurringOrderService.CancelRecurringOrder(ctx, recurringOrderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderinternal.CancelRecurringOrderResponse{}, nil
+}
+
+func (a *orderInternalAPI) FindRecurringOrder(ctx context.Context, request *orderinternal.FindRecurringOrderRequest) (*orderinternal.FindRecurringOrderResponse, error) {
+	recurringOrderID, err := uuid.Parse(request.RecurringOrderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recurring order id")
+	}
+
+	recurringOrder, err := a.recurringOrderQueryService.FindRecurringOrder(ctx, recurringOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*orderinternal.OrderItem, len(recurringOrder.Items))
+	for i, item := range recurringOrder.Items {
+		items[i] = &orderinternal.OrderItem{
+			ProductID: item.ProductID.String(),
+			Quantity:  int32(item.Quantity), // nolint:gosec
+		}
+	}
+
+	runs := make([]*orderinternal.RecurringOrderRun, len(recurringOrder.Runs))
+	for i, run := range recurringOrder.Runs {
+		runs[i] = &orderinternal.RecurringOrderRun{
+			RunID:     run.RunID.String(),
+			Status:    orderinternal.RecurringRunStatus(run.Status), // nolint:gosec
+			Error:     run.Error,
+			CreatedAt: run.CreatedAt,
+			UpdatedAt: run.UpdatedAt,
+		}
+		if run.OrderID != nil {
+			runs[i].OrderID = run.OrderID.String()
+		}
+	}
+
+	return &orderinternal.FindRecurringOrderResponse{
+		RecurringOrder: &orderinternal.RecurringOrder{
+			RecurringOrderID: recurringOrder.RecurringOrderID.String(),
+			UserID:           recurringOrder.UserID.String(),
+			Items:            items,
+			Interval:         recurringOrder.Interval,
+			Status:           orderinternal.RecurringOrderStatus(recurringOrder.Status), // nolint:gosec
+			LastFailure:      recurringOrder.LastFailure,
+			CreatedAt:        recurringOrder.CreatedAt,
+			UpdatedAt:        recurringOrder.UpdatedAt,
+			Runs:             runs,
+		},
+	}, nil
+}
+
+// toReportPeriod требует явный период: без него отчет молча оказался бы пустым
+func toReportPeriod(period *orderinternal.ReportPeriod) (appmodel.ReportPeriod, error) {
+	if period == nil {
+		return appmodel.ReportPeriod{}, status.Error(codes.InvalidArgument, "report period is required")
+	}
+	return appmodel.ReportPeriod{
+		From: time.Unix(period.From, 0),
+		To:   time.Unix(period.To, 0),
+	}, nil
+}
+
+func parseCartItemIDs(rawUserID, rawProductID string) (userID, productID uuid.UUID, err error) {
+	userID, err = uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Wrap(err, "invalid user id")
+	}
+	productID, err = uuid.Parse(rawProductID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Wrapf(err, "invalid product id: %s", rawProductID)
+	}
+	return userID, productID, nil
+}
+
+func toOrderProto(order appmodel.Order) *orderinternal.Order {
+	items := make([]*orderinternal.OrderItem, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = &orderinternal.OrderItem{
+			ProductID: item.ProductID.String(),
+			Quantity:  int32(item.Quantity), // nolint:gosec
+		}
+	}
+
+	history := make([]*orderinternal.StatusTransition, len(order.History))
+	for i, transition := range order.History {
+		history[i] = &orderinternal.StatusTransition{
+			To:         orderinternal.OrderStatus(transition.To), // nolint:gosec
+			Reason:     transition.Reason,
+			Actor:      transition.Actor,
+			OccurredAt: transition.OccurredAt,
+		}
+		if transition.From != nil {
+			from := orderinternal.OrderStatus(*transition.From) // nolint:gosec
+			history[i].From = &from
+		}
+	}
+
+	return &orderinternal.Order{
+		OrderID:        order.OrderID.String(),
+		UserID:         order.UserID.String(),
+		Items:          items,
+		TotalPrice:     order.TotalPrice,
+		Status:         orderinternal.OrderStatus(order.Status), // nolint:gosec
+		CreatedAt:      order.CreatedAt,
+		History:        history,
+		Carrier:        order.Carrier,
+		TrackingNumber: order.TrackingNumber,
+		Discount:       order.Discount,
+		PromoCode:      order.PromoCode,
+	}
+}
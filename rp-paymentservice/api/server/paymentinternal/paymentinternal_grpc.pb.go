@@ -24,6 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type PaymentInternalServiceClient interface {
 	StoreUserBalance(ctx context.Context, in *StoreUserBalanceRequest, opts ...grpc.CallOption) (*StoreUserBalanceResponse, error)
 	FindUserBalance(ctx context.Context, in *FindUserBalanceRequest, opts ...grpc.CallOption) (*FindUserBalanceResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
+	Capture(ctx context.Context, in *CaptureRequest, opts ...grpc.CallOption) (*CaptureResponse, error)
+	Void(ctx context.Context, in *VoidRequest, opts ...grpc.CallOption) (*VoidResponse, error)
 }
 
 type paymentInternalServiceClient struct {
@@ -52,12 +56,52 @@ func (c *paymentInternalServiceClient) FindUserBalance(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *paymentInternalServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/Payment.PaymentInternalService/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentInternalServiceClient) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
+	out := new(AuthorizeResponse)
+	err := c.cc.Invoke(ctx, "/Payment.PaymentInternalService/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentInternalServiceClient) Capture(ctx context.Context, in *CaptureRequest, opts ...grpc.CallOption) (*CaptureResponse, error) {
+	out := new(CaptureResponse)
+	err := c.cc.Invoke(ctx, "/Payment.PaymentInternalService/Capture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentInternalServiceClient) Void(ctx context.Context, in *VoidRequest, opts ...grpc.CallOption) (*VoidResponse, error) {
+	out := new(VoidResponse)
+	err := c.cc.Invoke(ctx, "/Payment.PaymentInternalService/Void", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentInternalServiceServer is the server API for PaymentInternalService service.
 // All implementations must embed UnimplementedPaymentInternalServiceServer
 // for forward compatibility
 type PaymentInternalServiceServer interface {
 	StoreUserBalance(context.Context, *StoreUserBalanceRequest) (*StoreUserBalanceResponse, error)
 	FindUserBalance(context.Context, *FindUserBalanceRequest) (*FindUserBalanceResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	Capture(context.Context, *CaptureRequest) (*CaptureResponse, error)
+	Void(context.Context, *VoidRequest) (*VoidResponse, error)
 	mustEmbedUnimplementedPaymentInternalServiceServer()
 }
 
@@ -71,6 +115,18 @@ func (UnimplementedPaymentInternalServiceServer) StoreUserBalance(context.Contex
 func (UnimplementedPaymentInternalServiceServer) FindUserBalance(context.Context, *FindUserBalanceRequest) (*FindUserBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserBalance not implemented")
 }
+func (UnimplementedPaymentInternalServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedPaymentInternalServiceServer) Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedPaymentInternalServiceServer) Capture(context.Context, *CaptureRequest) (*CaptureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capture not implemented")
+}
+func (UnimplementedPaymentInternalServiceServer) Void(context.Context, *VoidRequest) (*VoidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Void not implemented")
+}
 func (UnimplementedPaymentInternalServiceServer) mustEmbedUnimplementedPaymentInternalServiceServer() {
 }
 
@@ -121,6 +177,78 @@ func _PaymentInternalService_FindUserBalance_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentInternalService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentInternalServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Payment.PaymentInternalService/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentInternalServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentInternalService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentInternalServiceServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Payment.PaymentInternalService/Authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentInternalServiceServer).Authorize(ctx, req.(*AuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentInternalService_Capture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentInternalServiceServer).Capture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Payment.PaymentInternalService/Capture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentInternalServiceServer).Capture(ctx, req.(*CaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentInternalService_Void_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentInternalServiceServer).Void(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Payment.PaymentInternalService/Void",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentInternalServiceServer).Void(ctx, req.(*VoidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentInternalService_ServiceDesc is the grpc.ServiceDesc for PaymentInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,6 +264,22 @@ var PaymentInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserBalance",
 			Handler:    _PaymentInternalService_FindUserBalance_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _PaymentInternalService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _PaymentInternalService_Authorize_Handler,
+		},
+		{
+			MethodName: "Capture",
+			Handler:    _PaymentInternalService_Capture_Handler,
+		},
+		{
+			MethodName: "Void",
+			Handler:    _PaymentInternalService_Void_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/server/paymentinternal/paymentinternal.proto",
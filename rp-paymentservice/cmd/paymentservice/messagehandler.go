@@ -12,15 +12,21 @@ import (
 	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/outbox"
 	"github.com/gorilla/mux"
 	"github.com/urfave/cli/v2"
+	"go.temporal.io/sdk/client"
 	"golang.org/x/sync/errgroup"
 
 	"paymentservice/pkg/payment/infrastructure/integrationevent"
+	"paymentservice/pkg/payment/infrastructure/temporal/workflowoutbox"
 )
 
+// сага заказа ждет сигнал о пополнении, стандартные 10 секунд outbox для нее слишком долго
+var workflowOutboxSendInterval = time.Second
+
 type messageHandlerConfig struct {
 	Service  Service  `envconfig:"service"`
 	Database Database `envconfig:"database" required:"true"`
 	AMQP     AMQP     `envconfig:"amqp" required:"true"`
+	Temporal Temporal `envconfig:"temporal" required:"true"`
 }
 
 func messageHandler(logger logging.Logger) *cli.Command {
@@ -45,6 +51,17 @@ func messageHandler(logger logging.Logger) *cli.Command {
 			closer.AddCloser(databaseConnector)
 			databaseConnectionPool := mysql.NewConnectionPool(databaseConnector.TransactionalClient())
 
+			temporalClient, err := client.Dial(client.Options{
+				HostPort: cnf.Temporal.Host,
+			})
+			if err != nil {
+				return err
+			}
+			closer.AddCloser(libio.CloserFunc(func() error {
+				temporalClient.Close()
+				return nil
+			}))
+
 			amqpConnection := newAMQPConnection(cnf.AMQP, logger)
 			amqpEventProducer := amqpConnection.Producer(
 				&amqp.ExchangeConfig{
@@ -70,10 +87,21 @@ func messageHandler(logger logging.Logger) *cli.Command {
 				Logger:         logger,
 			})
 
+			workflowOutboxHandler := outbox.NewEventHandler(outbox.EventHandlerConfig{
+				TransportName:  workflowoutbox.TransportName,
+				Transport:      workflowoutbox.NewTransport(logger, temporalClient),
+				ConnectionPool: databaseConnectionPool,
+				Logger:         logger,
+				SendInterval:   &workflowOutboxSendInterval,
+			})
+
 			errGroup := errgroup.Group{}
 			errGroup.Go(func() error {
 				return outboxEventHandler.Start(c.Context)
 			})
+			errGroup.Go(func() error {
+				return workflowOutboxHandler.Start(c.Context)
+			})
 
 			errGroup.Go(func() error {
 				router := mux.NewRouter()
@@ -11,6 +11,7 @@ import (
 
 	"paymentservice/pkg/payment/infrastructure/integrationevent"
 	"paymentservice/pkg/payment/infrastructure/migrations/database"
+	"paymentservice/pkg/payment/infrastructure/temporal/workflowoutbox"
 )
 
 type migrateConfig struct {
@@ -60,6 +61,12 @@ func migrateImpl(logger logging.Logger) func(c *cli.Context) error {
 		}
 		closer.AddCloser(domainOutboxRelease)
 
+		workflowOutboxMigrator, workflowOutboxRelease, err := outboxmigrations.NewOutboxMigrator(c.Context, connPool, logger, workflowoutbox.TransportName)
+		if err != nil {
+			return err
+		}
+		closer.AddCloser(workflowOutboxRelease)
+
 		err = databaseMigrator.Migrate()
 		if err != nil {
 			return err
@@ -68,6 +75,10 @@ func migrateImpl(logger logging.Logger) func(c *cli.Context) error {
 		if err != nil {
 			return err
 		}
+		err = workflowOutboxMigrator.Migrate()
+		if err != nil {
+			return err
+		}
 
 		return nil
 	}
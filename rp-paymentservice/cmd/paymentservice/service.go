@@ -22,6 +22,7 @@ import (
 	"paymentservice/pkg/payment/infrastructure/integrationevent"
 	inframysql "paymentservice/pkg/payment/infrastructure/mysql"
 	"paymentservice/pkg/payment/infrastructure/mysql/query"
+	"paymentservice/pkg/payment/infrastructure/temporal/workflowoutbox"
 	"paymentservice/pkg/payment/infrastructure/transport"
 	"paymentservice/pkg/payment/infrastructure/transport/middlewares"
 )
@@ -58,10 +59,11 @@ func service(logger logging.Logger) *cli.Command {
 			uow := inframysql.NewUnitOfWork(libUoW)
 			luow := inframysql.NewLockableUnitOfWork(libLUow)
 			eventDispatcher := outbox.NewEventDispatcher(appID, integrationevent.TransportName, integrationevent.NewEventSerializer(), libUoW)
+			workflowDispatcher := outbox.NewEventDispatcher(appID, workflowoutbox.TransportName, workflowoutbox.NewCommandSerializer(), libUoW)
 
 			paymentInternalAPI := transport.NewPaymentInternalAPI(
 				query.NewAccountQueryService(databaseConnector.TransactionalClient()),
-				appservice.NewAccountService(uow, luow, eventDispatcher),
+				appservice.NewAccountService(uow, luow, eventDispatcher, workflowDispatcher),
 			)
 
 			errGroup := errgroup.Group{}
@@ -18,6 +18,8 @@ import (
 	"paymentservice/pkg/payment/infrastructure/integrationevent"
 	inframysql "paymentservice/pkg/payment/infrastructure/mysql"
 	"paymentservice/pkg/payment/infrastructure/temporal/activity"
+	"paymentservice/pkg/payment/infrastructure/temporal/workflowoutbox"
+	"paymentservice/pkg/payment/infrastructure/temporal/workflows"
 )
 
 type workflowWorkerConfig struct {
@@ -65,10 +67,12 @@ func workflowWorker(logger logging.Logger) *cli.Command {
 			uow := inframysql.NewUnitOfWork(libUoW)
 			luow := inframysql.NewLockableUnitOfWork(libLUow)
 			eventDispatcher := outbox.NewEventDispatcher(appID, integrationevent.TransportName, integrationevent.NewEventSerializer(), libUoW)
+			workflowDispatcher := outbox.NewEventDispatcher(appID, workflowoutbox.TransportName, workflowoutbox.NewCommandSerializer(), libUoW)
 
-			accountService := appservice.NewAccountService(uow, luow, eventDispatcher)
+			accountService := appservice.NewAccountService(uow, luow, eventDispatcher, workflowDispatcher)
 
-			w := worker.New(temporalClient, "paymentservice_task_queue", worker.Options{})
+			w := worker.New(temporalClient, workflows.PaymentTaskQueue, worker.Options{})
+			w.RegisterWorkflow(workflows.HoldExpiryWorkflow)
 
 			activities := activity.NewPaymentActivities(accountService)
 			w.RegisterActivity(activities)
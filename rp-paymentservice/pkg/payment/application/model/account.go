@@ -1,8 +1,36 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type UserBalance struct {
 	UserID  uuid.UUID
 	Balance int64
+	// Available - баланс за вычетом холдов, заполняется только при чтении
+	Available int64
+}
+
+type Transaction struct {
+	TransactionID uuid.UUID
+	UserID        uuid.UUID
+	Type          int
+	Amount        int64
+	Balance       int64
+	OrderID       *uuid.UUID
+	CreatedAt     time.Time
+}
+
+type ListTransactions struct {
+	UserID uuid.UUID
+	// AfterID - курсор: последняя транзакция предыдущей страницы
+	AfterID  *uuid.UUID
+	PageSize int
+}
+
+type TransactionsPage struct {
+	Transactions []Transaction
+	NextAfterID  *uuid.UUID
 }
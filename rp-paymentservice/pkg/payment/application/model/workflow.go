@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotifyBalanceToppedUp - команда сообщить саге заказа, что баланс пользователя вырос.
+// Пишется в outbox вместе с изменением баланса, сигнал в Temporal шлет message-handler
+type NotifyBalanceToppedUp struct {
+	OrderID uuid.UUID
+	UserID  uuid.UUID
+}
+
+func (c NotifyBalanceToppedUp) Type() string {
+	return "notify_balance_topped_up"
+}
+
+// StartHoldExpiryWorkflow - команда завести таймер, который отпустит холд, если его не списали до ExpiresAt
+type StartHoldExpiryWorkflow struct {
+	OrderID   uuid.UUID
+	ExpiresAt time.Time
+}
+
+func (c StartHoldExpiryWorkflow) Type() string {
+	return "start_hold_expiry_workflow"
+}
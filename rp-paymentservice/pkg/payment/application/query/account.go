@@ -10,4 +10,5 @@ import (
 
 type AccountQueryService interface {
 	FindUserBalance(ctx context.Context, userID uuid.UUID) (*appmodel.UserBalance, error)
+	ListTransactions(ctx context.Context, spec appmodel.ListTransactions) (appmodel.TransactionsPage, error)
 }
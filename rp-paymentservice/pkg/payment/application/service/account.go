@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
 	"github.com/google/uuid"
@@ -16,64 +17,250 @@ import (
 
 type AccountService interface {
 	StoreUserBalance(ctx context.Context, balance appmodel.UserBalance) error
-	Charge(ctx context.Context, userID uuid.UUID, amount int64) error
-	Refund(ctx context.Context, userID uuid.UUID, amount int64) error
+	Charge(ctx context.Context, userID, orderID uuid.UUID, amount int64) error
+	Refund(ctx context.Context, userID, orderID uuid.UUID, amount int64) error
+	RefundReturn(ctx context.Context, userID, orderID, returnID uuid.UUID, amount int64) error
+	// Authorize откладывает деньги под заказ на holdTTL и заводит таймер, который отпустит холд по истечении срока.
+	// Срок задает сага заказа: она же проверяет, что ожидание одобрения и окно оплаты в него укладываются
+	Authorize(ctx context.Context, userID, orderID uuid.UUID, amount int64, holdTTL time.Duration) error
+	Capture(ctx context.Context, orderID uuid.UUID) error
+	Void(ctx context.Context, orderID uuid.UUID) error
+	ExpireHold(ctx context.Context, orderID uuid.UUID) error
+	// AwaitTopUp заводит ожидание пополнения: когда денег на заказ станет хватать, его сага получит сигнал.
+	// Ожидание снимают Void и Expire, поэтому сага заводит его, только пока держит окно оплаты открытым
+	AwaitTopUp(ctx context.Context, userID, orderID uuid.UUID, amount int64) error
 }
 
 func NewAccountService(
 	uow UnitOfWork,
 	luow LockableUnitOfWork,
 	eventDispatcher outbox.EventDispatcher[outbox.Event],
+	workflowDispatcher outbox.EventDispatcher[outbox.Event],
 ) AccountService {
 	return &accountService{
-		uow:             uow,
-		luow:            luow,
-		eventDispatcher: eventDispatcher,
+		uow:                uow,
+		luow:               luow,
+		eventDispatcher:    eventDispatcher,
+		workflowDispatcher: workflowDispatcher,
 	}
 }
 
 type accountService struct {
-	uow             UnitOfWork
-	luow            LockableUnitOfWork
-	eventDispatcher outbox.EventDispatcher[outbox.Event]
+	uow                UnitOfWork
+	luow               LockableUnitOfWork
+	eventDispatcher    outbox.EventDispatcher[outbox.Event]
+	workflowDispatcher outbox.EventDispatcher[outbox.Event]
 }
 
 func (s *accountService) StoreUserBalance(ctx context.Context, balance appmodel.UserBalance) error {
 	lockName := userBalanceLock(balance.UserID)
 
 	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
-		domainService := s.domainService(ctx, provider.AccountRepository(ctx))
+		domainService := s.domainService(ctx, provider)
 
-		_, err := provider.AccountRepository(ctx).Find(model.FindSpec{UserID: &balance.UserID})
+		account, err := provider.AccountRepository(ctx).Find(model.FindSpec{UserID: &balance.UserID})
 		if errors.Is(err, model.ErrAccountNotFound) {
 			return domainService.CreateAccount(balance.UserID, balance.Balance)
 		}
 		if err != nil {
 			return err
 		}
+		balanceBefore := account.Balance
 
-		return domainService.UpdateBalance(balance.UserID, balance.Balance)
+		err = domainService.UpdateBalance(balance.UserID, balance.Balance)
+		if err != nil {
+			return err
+		}
+		if balance.Balance > balanceBefore {
+			return s.notifyPendingPayments(ctx, domainService, balance.UserID)
+		}
+		return nil
 	})
 }
 
-func (s *accountService) Charge(ctx context.Context, userID uuid.UUID, amount int64) error {
+func (s *accountService) Charge(ctx context.Context, userID, orderID uuid.UUID, amount int64) error {
+	lockName := userBalanceLock(userID)
+	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
+		domainService := s.domainService(ctx, provider)
+		return domainService.Charge(userID, orderID, amount)
+	})
+}
+
+func (s *accountService) Authorize(ctx context.Context, userID, orderID uuid.UUID, amount int64, holdTTL time.Duration) error {
+	if holdTTL <= 0 {
+		return errors.New("hold TTL is required")
+	}
+
 	lockName := userBalanceLock(userID)
 	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
-		domainService := s.domainService(ctx, provider.AccountRepository(ctx))
-		return domainService.Charge(userID, amount)
+		err := s.domainService(ctx, provider).Authorize(userID, orderID, amount, time.Now().Add(holdTTL))
+		if err != nil {
+			return err
+		}
+
+		// повторный Authorize вернет тот же холд, а команда таймера с тем же сроком ничего не изменит
+		hold, err := provider.HoldRepository(ctx).Find(orderID)
+		if err != nil {
+			return err
+		}
+		return s.workflowDispatcher.Dispatch(ctx, &appmodel.StartHoldExpiryWorkflow{
+			OrderID:   hold.OrderID,
+			ExpiresAt: hold.ExpiresAt,
+		})
+	})
+}
+
+func (s *accountService) Capture(ctx context.Context, orderID uuid.UUID) error {
+	return s.settleHold(ctx, orderID, func(domainService service.AccountService) error {
+		return domainService.Capture(orderID)
+	})
+}
+
+func (s *accountService) Void(ctx context.Context, orderID uuid.UUID) error {
+	err := s.settleHold(ctx, orderID, func(domainService service.AccountService) error {
+		return domainService.Void(orderID)
+	})
+	// холд так и не поставили - отпускать нечего, но заказ мог ждать пополнения
+	if errors.Is(err, model.ErrHoldNotFound) {
+		return s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+			return s.domainService(ctx, provider).Void(orderID)
+		})
+	}
+	return err
+}
+
+func (s *accountService) ExpireHold(ctx context.Context, orderID uuid.UUID) error {
+	return s.settleHold(ctx, orderID, func(domainService service.AccountService) error {
+		return domainService.Expire(orderID)
+	})
+}
+
+// settleHold меняет холд под локом баланса его владельца. Владельца узнаем из самого холда
+func (s *accountService) settleHold(ctx context.Context, orderID uuid.UUID, settle func(domainService service.AccountService) error) error {
+	var hold *model.Hold
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		var err error
+		hold, err = provider.HoldRepository(ctx).Find(orderID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.luow.Execute(ctx, []string{userBalanceLock(hold.UserID)}, func(provider RepositoryProvider) error {
+		domainService := s.domainService(ctx, provider)
+		holdRepository := provider.HoldRepository(ctx)
+
+		before, err := holdRepository.Find(orderID)
+		if err != nil {
+			return err
+		}
+		err = settle(domainService)
+		if err != nil {
+			return err
+		}
+		after, err := holdRepository.Find(orderID)
+		if err != nil {
+			return err
+		}
+
+		// отпущенный холд вернул деньги в доступный остаток - будим заказы, которые ждут пополнения
+		released := after.Status == model.HoldVoided || after.Status == model.HoldExpired
+		if before.Status == model.HoldAuthorized && released {
+			return s.notifyPendingPayments(ctx, domainService, hold.UserID)
+		}
+		return nil
+	})
+}
+
+// AwaitTopUp вызывается после неудачного Authorize. Между ними баланс могли пополнить, не застав ожидания, -
+// тогда будим заказ сразу
+func (s *accountService) AwaitTopUp(ctx context.Context, userID, orderID uuid.UUID, amount int64) error {
+	return s.luow.Execute(ctx, []string{userBalanceLock(userID)}, func(provider RepositoryProvider) error {
+		domainService := s.domainService(ctx, provider)
+		err := domainService.AddPendingPayment(userID, orderID, amount)
+		if err != nil {
+			return err
+		}
+
+		account, err := provider.AccountRepository(ctx).Find(model.FindSpec{UserID: &userID})
+		if err != nil {
+			return err
+		}
+		if account.Available() >= amount {
+			return s.notifyPendingPayments(ctx, domainService, userID)
+		}
+		return nil
+	})
+}
+
+func (s *accountService) Refund(ctx context.Context, userID, orderID uuid.UUID, amount int64) error {
+	return s.refund(ctx, userID, func(domainService service.AccountService) error {
+		return domainService.Refund(userID, orderID, amount)
+	})
+}
+
+func (s *accountService) RefundReturn(ctx context.Context, userID, orderID, returnID uuid.UUID, amount int64) error {
+	return s.refund(ctx, userID, func(domainService service.AccountService) error {
+		return domainService.RefundReturn(userID, orderID, returnID, amount)
 	})
 }
 
-func (s *accountService) Refund(ctx context.Context, userID uuid.UUID, amount int64) error {
+// refund выполняет возврат и, если баланс вырос, будит ожидающие оплаты
+func (s *accountService) refund(ctx context.Context, userID uuid.UUID, refund func(domainService service.AccountService) error) error {
 	lockName := userBalanceLock(userID)
 	return s.luow.Execute(ctx, []string{lockName}, func(provider RepositoryProvider) error {
-		domainService := s.domainService(ctx, provider.AccountRepository(ctx))
-		return domainService.Refund(userID, amount)
+		domainService := s.domainService(ctx, provider)
+
+		account, err := provider.AccountRepository(ctx).Find(model.FindSpec{UserID: &userID})
+		if err != nil {
+			return err
+		}
+		balanceBefore := account.Balance
+
+		err = refund(domainService)
+		if err != nil {
+			return err
+		}
+
+		account, err = provider.AccountRepository(ctx).Find(model.FindSpec{UserID: &userID})
+		if err != nil {
+			return err
+		}
+		if account.Balance > balanceBefore {
+			return s.notifyPendingPayments(ctx, domainService, userID)
+		}
+		return nil
 	})
 }
 
-func (s *accountService) domainService(ctx context.Context, repository model.AccountRepository) service.AccountService {
-	return service.NewAccountService(repository, s.domainEventDispatcher(ctx))
+// notifyPendingPayments будит саги заказов, которые ждут пополнения баланса пользователя
+func (s *accountService) notifyPendingPayments(ctx context.Context, domainService service.AccountService, userID uuid.UUID) error {
+	payments, err := domainService.TakePendingPayments(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, payment := range payments {
+		err = s.workflowDispatcher.Dispatch(ctx, &appmodel.NotifyBalanceToppedUp{
+			OrderID: payment.OrderID,
+			UserID:  payment.UserID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *accountService) domainService(ctx context.Context, provider RepositoryProvider) service.AccountService {
+	return service.NewAccountService(
+		provider.AccountRepository(ctx),
+		provider.TransactionRepository(ctx),
+		provider.PendingPaymentRepository(ctx),
+		provider.HoldRepository(ctx),
+		s.domainEventDispatcher(ctx),
+	)
 }
 
 func (s *accountService) domainEventDispatcher(ctx context.Context) domain.EventDispatcher {
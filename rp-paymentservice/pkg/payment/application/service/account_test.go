@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,28 @@ func (m *MockRepositoryProvider) AccountRepository(ctx context.Context) domainmo
 	return m.Called(ctx).Get(0).(domainmodel.AccountRepository)
 }
 
+func (m *MockRepositoryProvider) TransactionRepository(ctx context.Context) domainmodel.TransactionRepository {
+	return m.Called(ctx).Get(0).(domainmodel.TransactionRepository)
+}
+
+func (m *MockRepositoryProvider) PendingPaymentRepository(ctx context.Context) domainmodel.PendingPaymentRepository {
+	return m.Called(ctx).Get(0).(domainmodel.PendingPaymentRepository)
+}
+
+func (m *MockRepositoryProvider) HoldRepository(ctx context.Context) domainmodel.HoldRepository {
+	return m.Called(ctx).Get(0).(domainmodel.HoldRepository)
+}
+
+type MockUnitOfWork struct {
+	mock.Mock
+}
+
+func (m *MockUnitOfWork) Execute(ctx context.Context, f func(provider RepositoryProvider) error) error {
+	args := m.Called(ctx)
+	provider := args.Get(0).(RepositoryProvider)
+	return f(provider)
+}
+
 type MockLockableUnitOfWork struct {
 	mock.Mock
 }
@@ -52,6 +75,83 @@ func (m *StubAccountRepo) Find(s domainmodel.FindSpec) (*domainmodel.Account, er
 	return args.Get(0).(*domainmodel.Account), args.Error(1)
 }
 
+type StubTransactionRepo struct{}
+
+func (m *StubTransactionRepo) NextID() (uuid.UUID, error) {
+	return uuid.New(), nil
+}
+
+func (m *StubTransactionRepo) Store(_ domainmodel.Transaction) error {
+	return nil
+}
+
+func (m *StubTransactionRepo) FindForOrder(_ uuid.UUID, _ domainmodel.TransactionType) (*domainmodel.Transaction, error) {
+	return nil, domainmodel.ErrTransactionNotFound
+}
+
+func (m *StubTransactionRepo) FindForReturn(_ uuid.UUID) (*domainmodel.Transaction, error) {
+	return nil, domainmodel.ErrTransactionNotFound
+}
+
+type StubPendingPaymentRepo struct {
+	payments []domainmodel.PendingPayment
+}
+
+func (m *StubPendingPaymentRepo) Store(payment domainmodel.PendingPayment) error {
+	m.payments = append(m.payments, payment)
+	return nil
+}
+
+func (m *StubPendingPaymentRepo) FindForUser(userID uuid.UUID) ([]domainmodel.PendingPayment, error) {
+	var result []domainmodel.PendingPayment
+	for _, payment := range m.payments {
+		if payment.UserID == userID {
+			result = append(result, payment)
+		}
+	}
+	return result, nil
+}
+
+func (m *StubPendingPaymentRepo) Delete(orderID uuid.UUID) error {
+	payments := m.payments[:0]
+	for _, payment := range m.payments {
+		if payment.OrderID != orderID {
+			payments = append(payments, payment)
+		}
+	}
+	m.payments = payments
+	return nil
+}
+
+type StubHoldRepo struct {
+	holds map[uuid.UUID]domainmodel.Hold
+}
+
+func (m *StubHoldRepo) Store(hold domainmodel.Hold) error {
+	if m.holds == nil {
+		m.holds = map[uuid.UUID]domainmodel.Hold{}
+	}
+	m.holds[hold.OrderID] = hold
+	return nil
+}
+
+func (m *StubHoldRepo) Find(orderID uuid.UUID) (*domainmodel.Hold, error) {
+	hold, ok := m.holds[orderID]
+	if !ok {
+		return nil, domainmodel.ErrHoldNotFound
+	}
+	return &hold, nil
+}
+
+type RecordingDispatcher struct {
+	events []outbox.Event
+}
+
+func (d *RecordingDispatcher) Dispatch(_ context.Context, event outbox.Event) error {
+	d.events = append(d.events, event)
+	return nil
+}
+
 type DummyDispatcher struct{}
 
 func (d *DummyDispatcher) Dispatch(_ context.Context, _ outbox.Event) error {
@@ -63,7 +163,7 @@ func TestAccountService_StoreUserBalance(t *testing.T) {
 	luow := new(MockLockableUnitOfWork)
 	repo := new(StubAccountRepo)
 
-	service := NewAccountService(nil, luow, &DummyDispatcher{})
+	service := NewAccountService(nil, luow, &DummyDispatcher{}, &DummyDispatcher{})
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -77,6 +177,9 @@ func TestAccountService_StoreUserBalance(t *testing.T) {
 	t.Run("create_if_not_exists", func(t *testing.T) {
 		luow.On("Execute", ctx, mock.Anything).Return(provider)
 		provider.On("AccountRepository", ctx).Return(repo)
+		provider.On("TransactionRepository", ctx).Return(&StubTransactionRepo{})
+		provider.On("PendingPaymentRepository", ctx).Return(&StubPendingPaymentRepo{})
+		provider.On("HoldRepository", ctx).Return(&StubHoldRepo{})
 
 		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(nil, domainmodel.ErrAccountNotFound).Once()
 
@@ -92,6 +195,9 @@ func TestAccountService_StoreUserBalance(t *testing.T) {
 	t.Run("update_if_exists", func(t *testing.T) {
 		luow.On("Execute", ctx, mock.Anything).Return(provider)
 		provider.On("AccountRepository", ctx).Return(repo)
+		provider.On("TransactionRepository", ctx).Return(&StubTransactionRepo{})
+		provider.On("PendingPaymentRepository", ctx).Return(&StubPendingPaymentRepo{})
+		provider.On("HoldRepository", ctx).Return(&StubHoldRepo{})
 
 		existing := &domainmodel.Account{UserID: userID, Balance: 100}
 
@@ -106,3 +212,154 @@ func TestAccountService_StoreUserBalance(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestAccountService_PendingPayments(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	luow := new(MockLockableUnitOfWork)
+	repo := new(StubAccountRepo)
+	pendingRepo := &StubPendingPaymentRepo{}
+	workflowDispatcher := &RecordingDispatcher{}
+
+	luow.On("Execute", ctx, mock.Anything).Return(provider)
+	provider.On("AccountRepository", ctx).Return(repo)
+	provider.On("TransactionRepository", ctx).Return(&StubTransactionRepo{})
+	provider.On("PendingPaymentRepository", ctx).Return(pendingRepo)
+	provider.On("HoldRepository", ctx).Return(&StubHoldRepo{})
+
+	service := NewAccountService(nil, luow, &DummyDispatcher{}, workflowDispatcher)
+
+	t.Run("charge_insufficient_funds_does_not_store_pending", func(t *testing.T) {
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 100}, nil).Once()
+
+		// ждать пополнения или нет, решает сага: без окна оплаты заказ отменяется сразу
+		err := service.Charge(ctx, userID, orderID, 300)
+		assert.ErrorIs(t, err, domainmodel.ErrInsufficientFunds)
+		assert.Empty(t, pendingRepo.payments)
+	})
+
+	t.Run("await_top_up_stores_pending", func(t *testing.T) {
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 100}, nil).Once()
+
+		err := service.AwaitTopUp(ctx, userID, orderID, 300)
+		assert.NoError(t, err)
+		assert.Len(t, pendingRepo.payments, 1)
+		assert.Empty(t, workflowDispatcher.events)
+	})
+
+	t.Run("top_up_notifies_pending_order", func(t *testing.T) {
+		existing := &domainmodel.Account{UserID: userID, Balance: 100}
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(existing, nil).Twice()
+		repo.On("Store", mock.Anything).Return(nil).Once()
+
+		err := service.StoreUserBalance(ctx, appmodel.UserBalance{UserID: userID, Balance: 500})
+		assert.NoError(t, err)
+		assert.Empty(t, pendingRepo.payments)
+		assert.Equal(t, []outbox.Event{&appmodel.NotifyBalanceToppedUp{OrderID: orderID, UserID: userID}}, workflowDispatcher.events)
+	})
+
+	t.Run("top_up_before_pending_stored_notifies_immediately", func(t *testing.T) {
+		workflowDispatcher.events = nil
+		otherOrderID := uuid.New()
+		// пополнение успело пройти между неудачной авторизацией и сохранением ожидания
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 500}, nil).Once()
+
+		err := service.AwaitTopUp(ctx, userID, otherOrderID, 300)
+		assert.NoError(t, err)
+		assert.Empty(t, pendingRepo.payments)
+		assert.Equal(t, []outbox.Event{&appmodel.NotifyBalanceToppedUp{OrderID: otherOrderID, UserID: userID}}, workflowDispatcher.events)
+	})
+}
+
+func TestAccountService_Hold(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	provider := new(MockRepositoryProvider)
+	uow := new(MockUnitOfWork)
+	luow := new(MockLockableUnitOfWork)
+	repo := new(StubAccountRepo)
+	pendingRepo := &StubPendingPaymentRepo{}
+	holdRepo := &StubHoldRepo{}
+	workflowDispatcher := &RecordingDispatcher{}
+
+	uow.On("Execute", ctx).Return(provider)
+	luow.On("Execute", ctx, mock.Anything).Return(provider)
+	provider.On("AccountRepository", ctx).Return(repo)
+	provider.On("TransactionRepository", ctx).Return(&StubTransactionRepo{})
+	provider.On("PendingPaymentRepository", ctx).Return(pendingRepo)
+	provider.On("HoldRepository", ctx).Return(holdRepo)
+
+	service := NewAccountService(uow, luow, &DummyDispatcher{}, workflowDispatcher)
+
+	t.Run("authorize_starts_expiry_timer", func(t *testing.T) {
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 500}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a domainmodel.Account) bool {
+			return a.Balance == 500 && a.Held == 300
+		})).Return(nil).Once()
+
+		err := service.Authorize(ctx, userID, orderID, 300, time.Hour)
+		assert.NoError(t, err)
+
+		hold := holdRepo.holds[orderID]
+		assert.Equal(t, domainmodel.HoldAuthorized, hold.Status)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), hold.ExpiresAt, time.Minute)
+		assert.Equal(t, []outbox.Event{&appmodel.StartHoldExpiryWorkflow{OrderID: orderID, ExpiresAt: hold.ExpiresAt}}, workflowDispatcher.events)
+	})
+
+	otherOrderID := uuid.New()
+	t.Run("authorize_insufficient_funds", func(t *testing.T) {
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 500, Held: 300}, nil).Once()
+
+		err := service.Authorize(ctx, userID, otherOrderID, 300, time.Hour)
+		assert.ErrorIs(t, err, domainmodel.ErrInsufficientFunds)
+		assert.Empty(t, pendingRepo.payments)
+		assert.NotContains(t, holdRepo.holds, otherOrderID)
+
+		// сага открыла окно оплаты
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 500, Held: 300}, nil).Once()
+		err = service.AwaitTopUp(ctx, userID, otherOrderID, 300)
+		assert.NoError(t, err)
+		assert.Len(t, pendingRepo.payments, 1)
+	})
+
+	t.Run("void_releases_hold", func(t *testing.T) {
+		repo.On("Find", domainmodel.FindSpec{UserID: &userID}).Return(&domainmodel.Account{UserID: userID, Balance: 500, Held: 300}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a domainmodel.Account) bool {
+			return a.Balance == 500 && a.Held == 0
+		})).Return(nil).Once()
+
+		err := service.Void(ctx, orderID)
+		assert.NoError(t, err)
+		assert.Equal(t, domainmodel.HoldVoided, holdRepo.holds[orderID].Status)
+		// отпущенных денег хватает заказу, который ждал пополнения
+		assert.Empty(t, pendingRepo.payments)
+		assert.Contains(t, workflowDispatcher.events, &appmodel.NotifyBalanceToppedUp{OrderID: otherOrderID, UserID: userID})
+
+		err = service.Capture(ctx, orderID)
+		assert.ErrorIs(t, err, domainmodel.ErrHoldNotAuthorized)
+	})
+
+	t.Run("void_without_hold", func(t *testing.T) {
+		err := service.Void(ctx, uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("void_without_hold_drops_pending_payment", func(t *testing.T) {
+		waitingOrderID := uuid.New()
+		pendingRepo.payments = append(pendingRepo.payments, domainmodel.PendingPayment{OrderID: waitingOrderID, UserID: userID, Amount: 900})
+
+		err := service.Void(ctx, waitingOrderID)
+		assert.NoError(t, err)
+		assert.Empty(t, pendingRepo.payments)
+	})
+
+	t.Run("authorize_requires_hold_ttl", func(t *testing.T) {
+		err := service.Authorize(ctx, userID, uuid.New(), 300, 0)
+		assert.Error(t, err)
+	})
+}
@@ -8,6 +8,9 @@ import (
 
 type RepositoryProvider interface {
 	AccountRepository(ctx context.Context) model.AccountRepository
+	TransactionRepository(ctx context.Context) model.TransactionRepository
+	PendingPaymentRepository(ctx context.Context) model.PendingPaymentRepository
+	HoldRepository(ctx context.Context) model.HoldRepository
 }
 
 type LockableUnitOfWork interface {
@@ -15,10 +15,16 @@ var (
 type Account struct {
 	UserID    uuid.UUID
 	Balance   int64 // Баланс в копейках
+	Held      int64 // Сумма действующих холдов, в журнал не попадает до списания
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// Available - сколько можно списать или отложить под новый заказ
+func (a Account) Available() int64 {
+	return a.Balance - a.Held
+}
+
 type FindSpec struct {
 	UserID *uuid.UUID
 }
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrHoldNotFound = errors.New("payment hold not found")
+	// ErrHoldNotAuthorized - холд уже снят или истек, списать по нему нельзя
+	ErrHoldNotAuthorized = errors.New("payment hold is not authorized")
+)
+
+type HoldStatus int
+
+const (
+	HoldAuthorized HoldStatus = iota
+	HoldCaptured
+	HoldVoided
+	HoldExpired
+)
+
+// Hold - деньги, отложенные под заказ. Доступный баланс уже уменьшен, а баланс в журнале - нет,
+// пока холд не списан через Capture
+type Hold struct {
+	OrderID   uuid.UUID
+	UserID    uuid.UUID
+	Amount    int64
+	Status    HoldStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type HoldRepository interface {
+	Store(hold Hold) error
+	Find(orderID uuid.UUID) (*Hold, error)
+}
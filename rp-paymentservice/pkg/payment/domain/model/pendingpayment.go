@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PendingPayment - заказ, который не удалось оплатить из-за нехватки денег.
+// Сага заказа держит резерв и ждет, пока пользователь пополнит баланс
+type PendingPayment struct {
+	OrderID   uuid.UUID
+	UserID    uuid.UUID
+	Amount    int64
+	CreatedAt time.Time
+}
+
+type PendingPaymentRepository interface {
+	Store(payment PendingPayment) error
+	FindForUser(userID uuid.UUID) ([]PendingPayment, error)
+	Delete(orderID uuid.UUID) error
+}
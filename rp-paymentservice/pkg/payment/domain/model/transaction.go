@@ -0,0 +1,44 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrIdempotencyConflict - повторная операция по тому же заказу пришла с другой суммой
+	ErrIdempotencyConflict = errors.New("operation for this order was already processed with different amount")
+)
+
+type TransactionType int
+
+const (
+	TransactionCharge TransactionType = iota
+	TransactionRefund
+	TransactionTopUp
+	TransactionAdjustment
+)
+
+// Transaction - запись журнала операций по счету. Журнал только дополняется, записи не меняются
+type Transaction struct {
+	TransactionID uuid.UUID
+	UserID        uuid.UUID
+	Type          TransactionType
+	Amount        int64 // Изменение баланса в копейках, для списаний отрицательное
+	Balance       int64 // Баланс после операции
+	OrderID       *uuid.UUID
+	// ReturnID есть у возвратов денег за часть заказа, их может быть несколько на один заказ
+	ReturnID  *uuid.UUID
+	CreatedAt time.Time
+}
+
+type TransactionRepository interface {
+	NextID() (uuid.UUID, error)
+	Store(transaction Transaction) error
+	// FindForOrder ищет операцию по заказу целиком, возвраты по частям заказа не учитываются
+	FindForOrder(orderID uuid.UUID, transactionType TransactionType) (*Transaction, error)
+	FindForReturn(returnID uuid.UUID) (*Transaction, error)
+}
@@ -13,23 +13,40 @@ import (
 type AccountService interface {
 	CreateAccount(userID uuid.UUID, initialBalance int64) error
 	UpdateBalance(userID uuid.UUID, newBalance int64) error
-	Charge(userID uuid.UUID, amount int64) error
-	Refund(userID uuid.UUID, amount int64) error
+	Charge(userID, orderID uuid.UUID, amount int64) error
+	Refund(userID, orderID uuid.UUID, amount int64) error
+	RefundReturn(userID, orderID, returnID uuid.UUID, amount int64) error
+	// Authorize откладывает деньги под заказ до expiresAt, Capture списывает их, Void и Expire - отпускают
+	Authorize(userID, orderID uuid.UUID, amount int64, expiresAt time.Time) error
+	Capture(orderID uuid.UUID) error
+	Void(orderID uuid.UUID) error
+	Expire(orderID uuid.UUID) error
+	AddPendingPayment(userID, orderID uuid.UUID, amount int64) error
+	TakePendingPayments(userID uuid.UUID) ([]model.PendingPayment, error)
 }
 
 func NewAccountService(
 	accountRepository model.AccountRepository,
+	transactionRepository model.TransactionRepository,
+	pendingPaymentRepository model.PendingPaymentRepository,
+	holdRepository model.HoldRepository,
 	eventDispatcher domain.EventDispatcher,
 ) AccountService {
 	return &accountService{
-		accountRepository: accountRepository,
-		eventDispatcher:   eventDispatcher,
+		accountRepository:        accountRepository,
+		transactionRepository:    transactionRepository,
+		pendingPaymentRepository: pendingPaymentRepository,
+		holdRepository:           holdRepository,
+		eventDispatcher:          eventDispatcher,
 	}
 }
 
 type accountService struct {
-	accountRepository model.AccountRepository
-	eventDispatcher   domain.EventDispatcher
+	accountRepository        model.AccountRepository
+	transactionRepository    model.TransactionRepository
+	pendingPaymentRepository model.PendingPaymentRepository
+	holdRepository           model.HoldRepository
+	eventDispatcher          domain.EventDispatcher
 }
 
 func (s *accountService) CreateAccount(userID uuid.UUID, initialBalance int64) error {
@@ -54,6 +71,13 @@ func (s *accountService) CreateAccount(userID uuid.UUID, initialBalance int64) e
 		return err
 	}
 
+	if initialBalance != 0 {
+		err = s.appendTransaction(account, model.TransactionTopUp, initialBalance, nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return s.eventDispatcher.Dispatch(&model.AccountCreated{
 		UserID:    userID,
 		Balance:   initialBalance,
@@ -72,6 +96,7 @@ func (s *accountService) UpdateBalance(userID uuid.UUID, newBalance int64) error
 	}
 
 	currentTime := time.Now()
+	delta := newBalance - account.Balance
 	account.Balance = newBalance
 	account.UpdatedAt = currentTime
 
@@ -80,6 +105,15 @@ func (s *accountService) UpdateBalance(userID uuid.UUID, newBalance int64) error
 		return err
 	}
 
+	transactionType := model.TransactionTopUp
+	if delta < 0 {
+		transactionType = model.TransactionAdjustment
+	}
+	err = s.appendTransaction(*account, transactionType, delta, nil, nil)
+	if err != nil {
+		return err
+	}
+
 	return s.eventDispatcher.Dispatch(&model.AccountBalanceUpdated{
 		UserID:    userID,
 		Balance:   newBalance,
@@ -87,13 +121,21 @@ func (s *accountService) UpdateBalance(userID uuid.UUID, newBalance int64) error
 	})
 }
 
-func (s *accountService) Charge(userID uuid.UUID, amount int64) error {
+// Charge списывает деньги за заказ. Заказ служит ключом идемпотентности:
+// повторное списание по тому же заказу не трогает баланс
+func (s *accountService) Charge(userID, orderID uuid.UUID, amount int64) error {
+	processed, err := s.alreadyProcessed(orderID, model.TransactionCharge, -amount)
+	if err != nil || processed {
+		return err
+	}
+
 	account, err := s.accountRepository.Find(model.FindSpec{UserID: &userID})
 	if err != nil {
 		return err
 	}
 
-	if account.Balance < amount {
+	// отложенные под другие заказы деньги списывать нельзя
+	if account.Available() < amount {
 		return model.ErrInsufficientFunds
 	}
 
@@ -105,6 +147,58 @@ func (s *accountService) Charge(userID uuid.UUID, amount int64) error {
 		return err
 	}
 
+	err = s.appendTransaction(*account, model.TransactionCharge, -amount, &orderID, nil)
+	if err != nil {
+		return err
+	}
+
+	// заказ оплачен, ждать пополнения больше не нужно
+	err = s.pendingPaymentRepository.Delete(orderID)
+	if err != nil {
+		return err
+	}
+
+	return s.eventDispatcher.Dispatch(&model.AccountBalanceUpdated{
+		UserID:    userID,
+		Balance:   account.Balance,
+		UpdatedAt: account.UpdatedAt,
+	})
+}
+
+// Refund возвращает деньги за заказ. Если по заказу ничего не списывали, возвращать нечего -
+// поэтому компенсация заказа может вызывать Refund, не зная, дошло ли дело до оплаты
+func (s *accountService) Refund(userID, orderID uuid.UUID, amount int64) error {
+	processed, err := s.alreadyProcessed(orderID, model.TransactionRefund, amount)
+	if err != nil || processed {
+		return err
+	}
+
+	_, err = s.transactionRepository.FindForOrder(orderID, model.TransactionCharge)
+	if errors.Is(err, model.ErrTransactionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	account, err := s.accountRepository.Find(model.FindSpec{UserID: &userID})
+	if err != nil {
+		return err
+	}
+
+	account.Balance += amount
+	account.UpdatedAt = time.Now()
+
+	err = s.accountRepository.Store(*account)
+	if err != nil {
+		return err
+	}
+
+	err = s.appendTransaction(*account, model.TransactionRefund, amount, &orderID, nil)
+	if err != nil {
+		return err
+	}
+
 	return s.eventDispatcher.Dispatch(&model.AccountBalanceUpdated{
 		UserID:    userID,
 		Balance:   account.Balance,
@@ -112,7 +206,26 @@ func (s *accountService) Charge(userID uuid.UUID, amount int64) error {
 	})
 }
 
-func (s *accountService) Refund(userID uuid.UUID, amount int64) error {
+// RefundReturn возвращает деньги за часть заказа. Ключ идемпотентности - возврат, а не заказ:
+// по одному заказу может быть несколько возвратов
+func (s *accountService) RefundReturn(userID, orderID, returnID uuid.UUID, amount int64) error {
+	transaction, err := s.transactionRepository.FindForReturn(returnID)
+	if err == nil {
+		if transaction.Amount != amount {
+			return model.ErrIdempotencyConflict
+		}
+		return nil
+	}
+	if !errors.Is(err, model.ErrTransactionNotFound) {
+		return err
+	}
+
+	// вернуть можно только то, что списали
+	_, err = s.transactionRepository.FindForOrder(orderID, model.TransactionCharge)
+	if err != nil {
+		return err
+	}
+
 	account, err := s.accountRepository.Find(model.FindSpec{UserID: &userID})
 	if err != nil {
 		return err
@@ -126,9 +239,226 @@ func (s *accountService) Refund(userID uuid.UUID, amount int64) error {
 		return err
 	}
 
+	err = s.appendTransaction(*account, model.TransactionRefund, amount, &orderID, &returnID)
+	if err != nil {
+		return err
+	}
+
 	return s.eventDispatcher.Dispatch(&model.AccountBalanceUpdated{
 		UserID:    userID,
 		Balance:   account.Balance,
 		UpdatedAt: account.UpdatedAt,
 	})
 }
+
+// Authorize откладывает деньги под заказ. Заказ служит ключом идемпотентности, как и в Charge.
+// Отпущенный или истекший холд денег уже не держит, и повторный Authorize по нему не считается успешным
+func (s *accountService) Authorize(userID, orderID uuid.UUID, amount int64, expiresAt time.Time) error {
+	hold, err := s.holdRepository.Find(orderID)
+	if err == nil {
+		if hold.Amount != amount {
+			return model.ErrIdempotencyConflict
+		}
+		if hold.Status != model.HoldAuthorized {
+			return model.ErrHoldNotAuthorized
+		}
+		return nil
+	}
+	if !errors.Is(err, model.ErrHoldNotFound) {
+		return err
+	}
+
+	account, err := s.accountRepository.Find(model.FindSpec{UserID: &userID})
+	if err != nil {
+		return err
+	}
+
+	if account.Available() < amount {
+		return model.ErrInsufficientFunds
+	}
+
+	currentTime := time.Now()
+	account.Held += amount
+	account.UpdatedAt = currentTime
+
+	err = s.accountRepository.Store(*account)
+	if err != nil {
+		return err
+	}
+
+	err = s.holdRepository.Store(model.Hold{
+		OrderID:   orderID,
+		UserID:    userID,
+		Amount:    amount,
+		Status:    model.HoldAuthorized,
+		ExpiresAt: expiresAt,
+		CreatedAt: currentTime,
+		UpdatedAt: currentTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	// деньги отложены, ждать пополнения больше не нужно
+	return s.pendingPaymentRepository.Delete(orderID)
+}
+
+// Capture списывает отложенные деньги. В журнал пишется обычное списание по заказу,
+// поэтому вернуть деньги по нему можно через Refund
+func (s *accountService) Capture(orderID uuid.UUID) error {
+	hold, err := s.holdRepository.Find(orderID)
+	if err != nil {
+		return err
+	}
+	if hold.Status == model.HoldCaptured {
+		return nil
+	}
+	if hold.Status != model.HoldAuthorized {
+		return model.ErrHoldNotAuthorized
+	}
+
+	account, err := s.accountRepository.Find(model.FindSpec{UserID: &hold.UserID})
+	if err != nil {
+		return err
+	}
+
+	account.Held -= hold.Amount
+	account.Balance -= hold.Amount
+	account.UpdatedAt = time.Now()
+
+	err = s.accountRepository.Store(*account)
+	if err != nil {
+		return err
+	}
+
+	err = s.appendTransaction(*account, model.TransactionCharge, -hold.Amount, &orderID, nil)
+	if err != nil {
+		return err
+	}
+
+	err = s.changeHoldStatus(*hold, model.HoldCaptured, account.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return s.eventDispatcher.Dispatch(&model.AccountBalanceUpdated{
+		UserID:    hold.UserID,
+		Balance:   account.Balance,
+		UpdatedAt: account.UpdatedAt,
+	})
+}
+
+// Void отпускает холд. Как и Refund, его можно вызывать из компенсации, не зная, дошло ли дело до холда:
+// без холда и по уже списанному холду Void только снимает ожидание пополнения - списанное возвращает Refund
+func (s *accountService) Void(orderID uuid.UUID) error {
+	return s.release(orderID, model.HoldVoided)
+}
+
+// Expire отпускает холд, срок которого истек, если его не успели списать или снять
+func (s *accountService) Expire(orderID uuid.UUID) error {
+	return s.release(orderID, model.HoldExpired)
+}
+
+func (s *accountService) AddPendingPayment(userID, orderID uuid.UUID, amount int64) error {
+	return s.pendingPaymentRepository.Store(model.PendingPayment{
+		OrderID:   orderID,
+		UserID:    userID,
+		Amount:    amount,
+		CreatedAt: time.Now(),
+	})
+}
+
+// TakePendingPayments забирает ожидающие оплаты пользователя. Если денег снова не хватит,
+// сага заведет ожидание заново, пока окно оплаты не закрылось
+func (s *accountService) TakePendingPayments(userID uuid.UUID) ([]model.PendingPayment, error) {
+	payments, err := s.pendingPaymentRepository.FindForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payment := range payments {
+		err = s.pendingPaymentRepository.Delete(payment.OrderID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return payments, nil
+}
+
+// release отпускает холд и снимает ожидание пополнения: заказ, который отменили или не дождались, его больше не ждет.
+// Ожидание снимается и без холда - его заводят как раз тогда, когда холд поставить не удалось
+func (s *accountService) release(orderID uuid.UUID, status model.HoldStatus) error {
+	err := s.pendingPaymentRepository.Delete(orderID)
+	if err != nil {
+		return err
+	}
+
+	hold, err := s.holdRepository.Find(orderID)
+	if errors.Is(err, model.ErrHoldNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if hold.Status != model.HoldAuthorized {
+		return nil
+	}
+
+	account, err := s.accountRepository.Find(model.FindSpec{UserID: &hold.UserID})
+	if err != nil {
+		return err
+	}
+
+	account.Held -= hold.Amount
+	account.UpdatedAt = time.Now()
+
+	err = s.accountRepository.Store(*account)
+	if err != nil {
+		return err
+	}
+
+	return s.changeHoldStatus(*hold, status, account.UpdatedAt)
+}
+
+func (s *accountService) changeHoldStatus(hold model.Hold, status model.HoldStatus, updatedAt time.Time) error {
+	hold.Status = status
+	hold.UpdatedAt = updatedAt
+	return s.holdRepository.Store(hold)
+}
+
+func (s *accountService) alreadyProcessed(orderID uuid.UUID, transactionType model.TransactionType, amount int64) (bool, error) {
+	transaction, err := s.transactionRepository.FindForOrder(orderID, transactionType)
+	if errors.Is(err, model.ErrTransactionNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if transaction.Amount != amount {
+		return false, model.ErrIdempotencyConflict
+	}
+	return true, nil
+}
+
+func (s *accountService) appendTransaction(
+	account model.Account,
+	transactionType model.TransactionType,
+	amount int64,
+	orderID, returnID *uuid.UUID,
+) error {
+	transactionID, err := s.transactionRepository.NextID()
+	if err != nil {
+		return err
+	}
+
+	return s.transactionRepository.Store(model.Transaction{
+		TransactionID: transactionID,
+		UserID:        account.UserID,
+		Type:          transactionType,
+		Amount:        amount,
+		Balance:       account.Balance,
+		OrderID:       orderID,
+		ReturnID:      returnID,
+		CreatedAt:     account.UpdatedAt,
+	})
+}
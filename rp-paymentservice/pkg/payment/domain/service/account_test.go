@@ -2,6 +2,7 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,75 @@ func (m *MockAccountRepository) Find(spec model.FindSpec) (*model.Account, error
 	return args.Get(0).(*model.Account), args.Error(1)
 }
 
+type MockTransactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransactionRepository) NextID() (uuid.UUID, error) {
+	args := m.Called()
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *MockTransactionRepository) Store(transaction model.Transaction) error {
+	args := m.Called(transaction)
+	return args.Error(0)
+}
+
+func (m *MockTransactionRepository) FindForOrder(orderID uuid.UUID, transactionType model.TransactionType) (*model.Transaction, error) {
+	args := m.Called(orderID, transactionType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) FindForReturn(returnID uuid.UUID) (*model.Transaction, error) {
+	args := m.Called(returnID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Transaction), args.Error(1)
+}
+
+type MockPendingPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPendingPaymentRepository) Store(payment model.PendingPayment) error {
+	args := m.Called(payment)
+	return args.Error(0)
+}
+
+func (m *MockPendingPaymentRepository) FindForUser(userID uuid.UUID) ([]model.PendingPayment, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.PendingPayment), args.Error(1)
+}
+
+func (m *MockPendingPaymentRepository) Delete(orderID uuid.UUID) error {
+	args := m.Called(orderID)
+	return args.Error(0)
+}
+
+type MockHoldRepository struct {
+	mock.Mock
+}
+
+func (m *MockHoldRepository) Store(hold model.Hold) error {
+	args := m.Called(hold)
+	return args.Error(0)
+}
+
+func (m *MockHoldRepository) Find(orderID uuid.UUID) (*model.Hold, error) {
+	args := m.Called(orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Hold), args.Error(1)
+}
+
 type MockEventDispatcher struct {
 	mock.Mock
 }
@@ -44,8 +114,11 @@ func (m *MockEventDispatcher) Dispatch(event domain.Event) error {
 
 func TestAccountService_CreateAccount(t *testing.T) {
 	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	pendingRepo := new(MockPendingPaymentRepository)
+	holdRepo := new(MockHoldRepository)
 	dispatcher := new(MockEventDispatcher)
-	service := NewAccountService(repo, dispatcher)
+	service := NewAccountService(repo, transactionRepo, pendingRepo, holdRepo, dispatcher)
 
 	userID := uuid.New()
 	initialBalance := int64(1000)
@@ -55,6 +128,10 @@ func TestAccountService_CreateAccount(t *testing.T) {
 		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
 			return a.UserID == userID && a.Balance == initialBalance
 		})).Return(nil).Once()
+		transactionRepo.On("NextID").Return(uuid.New(), nil).Once()
+		transactionRepo.On("Store", mock.MatchedBy(func(tr model.Transaction) bool {
+			return tr.UserID == userID && tr.Type == model.TransactionTopUp && tr.Amount == initialBalance && tr.Balance == initialBalance
+		})).Return(nil).Once()
 		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.AccountCreated) bool {
 			return e.UserID == userID && e.Balance == initialBalance
 		})).Return(nil).Once()
@@ -62,6 +139,7 @@ func TestAccountService_CreateAccount(t *testing.T) {
 		err := service.CreateAccount(userID, initialBalance)
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
+		transactionRepo.AssertExpectations(t)
 	})
 
 	t.Run("already_exists", func(t *testing.T) {
@@ -75,8 +153,11 @@ func TestAccountService_CreateAccount(t *testing.T) {
 
 func TestAccountService_UpdateBalance(t *testing.T) {
 	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	pendingRepo := new(MockPendingPaymentRepository)
+	holdRepo := new(MockHoldRepository)
 	dispatcher := new(MockEventDispatcher)
-	service := NewAccountService(repo, dispatcher)
+	service := NewAccountService(repo, transactionRepo, pendingRepo, holdRepo, dispatcher)
 
 	userID := uuid.New()
 
@@ -86,6 +167,10 @@ func TestAccountService_UpdateBalance(t *testing.T) {
 		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
 			return a.UserID == userID && a.Balance == 200
 		})).Return(nil).Once()
+		transactionRepo.On("NextID").Return(uuid.New(), nil).Once()
+		transactionRepo.On("Store", mock.MatchedBy(func(tr model.Transaction) bool {
+			return tr.Type == model.TransactionTopUp && tr.Amount == 100 && tr.Balance == 200
+		})).Return(nil).Once()
 		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.AccountBalanceUpdated) bool {
 			return e.UserID == userID && e.Balance == 200
 		})).Return(nil).Once()
@@ -93,6 +178,7 @@ func TestAccountService_UpdateBalance(t *testing.T) {
 		err := service.UpdateBalance(userID, 200)
 		assert.NoError(t, err)
 		repo.AssertExpectations(t)
+		transactionRepo.AssertExpectations(t)
 	})
 
 	t.Run("no_change", func(t *testing.T) {
@@ -104,3 +190,334 @@ func TestAccountService_UpdateBalance(t *testing.T) {
 		repo.AssertNotCalled(t, "Store")
 	})
 }
+
+func TestAccountService_Charge(t *testing.T) {
+	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	pendingRepo := new(MockPendingPaymentRepository)
+	holdRepo := new(MockHoldRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewAccountService(repo, transactionRepo, pendingRepo, holdRepo, dispatcher)
+
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		transactionRepo.On("FindForOrder", orderID, model.TransactionCharge).Return(nil, model.ErrTransactionNotFound).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 500}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 200
+		})).Return(nil).Once()
+		transactionRepo.On("NextID").Return(uuid.New(), nil).Once()
+		transactionRepo.On("Store", mock.MatchedBy(func(tr model.Transaction) bool {
+			return tr.UserID == userID && tr.Type == model.TransactionCharge && tr.Amount == -300 && tr.Balance == 200 &&
+				tr.OrderID != nil && *tr.OrderID == orderID
+		})).Return(nil).Once()
+		pendingRepo.On("Delete", orderID).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.AccountBalanceUpdated")).Return(nil).Once()
+
+		err := service.Charge(userID, orderID, 300)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		transactionRepo.AssertExpectations(t)
+		pendingRepo.AssertExpectations(t)
+	})
+
+	t.Run("already_charged", func(t *testing.T) {
+		transactionRepo.On("FindForOrder", orderID, model.TransactionCharge).Return(&model.Transaction{Amount: -300}, nil).Once()
+
+		err := service.Charge(userID, orderID, 300)
+		assert.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("already_charged_different_amount", func(t *testing.T) {
+		transactionRepo.On("FindForOrder", orderID, model.TransactionCharge).Return(&model.Transaction{Amount: -300}, nil).Once()
+
+		err := service.Charge(userID, orderID, 500)
+		assert.ErrorIs(t, err, model.ErrIdempotencyConflict)
+	})
+
+	t.Run("insufficient_funds", func(t *testing.T) {
+		otherOrderID := uuid.New()
+		transactionRepo.On("FindForOrder", otherOrderID, model.TransactionCharge).Return(nil, model.ErrTransactionNotFound).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 100}, nil).Once()
+
+		err := service.Charge(userID, otherOrderID, 300)
+		assert.ErrorIs(t, err, model.ErrInsufficientFunds)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+}
+
+func TestAccountService_Refund(t *testing.T) {
+	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	pendingRepo := new(MockPendingPaymentRepository)
+	holdRepo := new(MockHoldRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewAccountService(repo, transactionRepo, pendingRepo, holdRepo, dispatcher)
+
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		transactionRepo.On("FindForOrder", orderID, model.TransactionRefund).Return(nil, model.ErrTransactionNotFound).Once()
+		transactionRepo.On("FindForOrder", orderID, model.TransactionCharge).Return(&model.Transaction{Amount: -300}, nil).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 200}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 500
+		})).Return(nil).Once()
+		transactionRepo.On("NextID").Return(uuid.New(), nil).Once()
+		transactionRepo.On("Store", mock.MatchedBy(func(tr model.Transaction) bool {
+			return tr.Type == model.TransactionRefund && tr.Amount == 300 && tr.Balance == 500
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.AccountBalanceUpdated")).Return(nil).Once()
+
+		err := service.Refund(userID, orderID, 300)
+		assert.NoError(t, err)
+		transactionRepo.AssertExpectations(t)
+	})
+
+	t.Run("already_refunded", func(t *testing.T) {
+		transactionRepo.On("FindForOrder", orderID, model.TransactionRefund).Return(&model.Transaction{Amount: 300}, nil).Once()
+
+		err := service.Refund(userID, orderID, 300)
+		assert.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("not_charged", func(t *testing.T) {
+		otherOrderID := uuid.New()
+		transactionRepo.On("FindForOrder", otherOrderID, model.TransactionRefund).Return(nil, model.ErrTransactionNotFound).Once()
+		transactionRepo.On("FindForOrder", otherOrderID, model.TransactionCharge).Return(nil, model.ErrTransactionNotFound).Once()
+
+		err := service.Refund(userID, otherOrderID, 300)
+		assert.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+}
+
+func TestAccountService_RefundReturn(t *testing.T) {
+	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewAccountService(repo, transactionRepo, new(MockPendingPaymentRepository), new(MockHoldRepository), dispatcher)
+
+	userID := uuid.New()
+	orderID := uuid.New()
+	returnID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		transactionRepo.On("FindForReturn", returnID).Return(nil, model.ErrTransactionNotFound).Once()
+		transactionRepo.On("FindForOrder", orderID, model.TransactionCharge).Return(&model.Transaction{Amount: -300}, nil).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 200}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 300
+		})).Return(nil).Once()
+		transactionRepo.On("NextID").Return(uuid.New(), nil).Once()
+		transactionRepo.On("Store", mock.MatchedBy(func(tr model.Transaction) bool {
+			return tr.Type == model.TransactionRefund && tr.Amount == 100 &&
+				tr.OrderID != nil && *tr.OrderID == orderID &&
+				tr.ReturnID != nil && *tr.ReturnID == returnID
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.AccountBalanceUpdated")).Return(nil).Once()
+
+		err := service.RefundReturn(userID, orderID, returnID, 100)
+		assert.NoError(t, err)
+		transactionRepo.AssertExpectations(t)
+	})
+
+	t.Run("already_refunded", func(t *testing.T) {
+		transactionRepo.On("FindForReturn", returnID).Return(&model.Transaction{Amount: 100}, nil).Once()
+
+		err := service.RefundReturn(userID, orderID, returnID, 100)
+		assert.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("different_amount", func(t *testing.T) {
+		transactionRepo.On("FindForReturn", returnID).Return(&model.Transaction{Amount: 100}, nil).Once()
+
+		err := service.RefundReturn(userID, orderID, returnID, 150)
+		assert.ErrorIs(t, err, model.ErrIdempotencyConflict)
+	})
+}
+
+func TestAccountService_PendingPayments(t *testing.T) {
+	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	pendingRepo := new(MockPendingPaymentRepository)
+	service := NewAccountService(repo, transactionRepo, pendingRepo, new(MockHoldRepository), new(MockEventDispatcher))
+
+	userID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("add", func(t *testing.T) {
+		pendingRepo.On("Store", mock.MatchedBy(func(p model.PendingPayment) bool {
+			return p.OrderID == orderID && p.UserID == userID && p.Amount == 300
+		})).Return(nil).Once()
+
+		err := service.AddPendingPayment(userID, orderID, 300)
+		assert.NoError(t, err)
+		pendingRepo.AssertExpectations(t)
+	})
+
+	t.Run("take", func(t *testing.T) {
+		pendingRepo.On("FindForUser", userID).Return([]model.PendingPayment{
+			{OrderID: orderID, UserID: userID, Amount: 300},
+		}, nil).Once()
+		pendingRepo.On("Delete", orderID).Return(nil).Once()
+
+		payments, err := service.TakePendingPayments(userID)
+		assert.NoError(t, err)
+		assert.Len(t, payments, 1)
+		pendingRepo.AssertExpectations(t)
+	})
+}
+
+func TestAccountService_Hold(t *testing.T) {
+	repo := new(MockAccountRepository)
+	transactionRepo := new(MockTransactionRepository)
+	pendingRepo := new(MockPendingPaymentRepository)
+	holdRepo := new(MockHoldRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewAccountService(repo, transactionRepo, pendingRepo, holdRepo, dispatcher)
+
+	userID := uuid.New()
+	orderID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("authorize", func(t *testing.T) {
+		holdRepo.On("Find", orderID).Return(nil, model.ErrHoldNotFound).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 500, Held: 100}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 500 && a.Held == 400
+		})).Return(nil).Once()
+		holdRepo.On("Store", mock.MatchedBy(func(h model.Hold) bool {
+			return h.OrderID == orderID && h.UserID == userID && h.Amount == 300 &&
+				h.Status == model.HoldAuthorized && h.ExpiresAt.Equal(expiresAt)
+		})).Return(nil).Once()
+		pendingRepo.On("Delete", orderID).Return(nil).Once()
+
+		err := service.Authorize(userID, orderID, 300, expiresAt)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		holdRepo.AssertExpectations(t)
+		transactionRepo.AssertNotCalled(t, "Store", mock.Anything)
+		dispatcher.AssertNotCalled(t, "Dispatch", mock.Anything)
+	})
+
+	t.Run("authorize_insufficient_available", func(t *testing.T) {
+		otherOrderID := uuid.New()
+		holdRepo.On("Find", otherOrderID).Return(nil, model.ErrHoldNotFound).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 500, Held: 400}, nil).Once()
+
+		err := service.Authorize(userID, otherOrderID, 300, expiresAt)
+		assert.ErrorIs(t, err, model.ErrInsufficientFunds)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("authorize_again", func(t *testing.T) {
+		holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, Amount: 300, Status: model.HoldAuthorized}, nil).Once()
+
+		assert.NoError(t, service.Authorize(userID, orderID, 300, expiresAt))
+		holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, Amount: 300, Status: model.HoldAuthorized}, nil).Once()
+		assert.ErrorIs(t, service.Authorize(userID, orderID, 500, expiresAt), model.ErrIdempotencyConflict)
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("authorize_released_hold", func(t *testing.T) {
+		for _, status := range []model.HoldStatus{model.HoldVoided, model.HoldExpired} {
+			holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, Amount: 300, Status: status}, nil).Once()
+
+			// деньги уже не отложены, сага не должна считать заказ авторизованным
+			assert.ErrorIs(t, service.Authorize(userID, orderID, 300, expiresAt), model.ErrHoldNotAuthorized)
+		}
+		repo.AssertNumberOfCalls(t, "Store", 1)
+	})
+
+	t.Run("capture", func(t *testing.T) {
+		holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, UserID: userID, Amount: 300, Status: model.HoldAuthorized}, nil).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 500, Held: 400}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 200 && a.Held == 100
+		})).Return(nil).Once()
+		transactionRepo.On("NextID").Return(uuid.New(), nil).Once()
+		transactionRepo.On("Store", mock.MatchedBy(func(tr model.Transaction) bool {
+			return tr.Type == model.TransactionCharge && tr.Amount == -300 && tr.Balance == 200 &&
+				tr.OrderID != nil && *tr.OrderID == orderID
+		})).Return(nil).Once()
+		holdRepo.On("Store", mock.MatchedBy(func(h model.Hold) bool {
+			return h.OrderID == orderID && h.Status == model.HoldCaptured
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.AccountBalanceUpdated")).Return(nil).Once()
+
+		err := service.Capture(orderID)
+		assert.NoError(t, err)
+		transactionRepo.AssertExpectations(t)
+		holdRepo.AssertExpectations(t)
+		dispatcher.AssertExpectations(t)
+	})
+
+	t.Run("capture_expired", func(t *testing.T) {
+		holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, UserID: userID, Amount: 300, Status: model.HoldExpired}, nil).Once()
+
+		err := service.Capture(orderID)
+		assert.ErrorIs(t, err, model.ErrHoldNotAuthorized)
+	})
+
+	t.Run("void", func(t *testing.T) {
+		holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, UserID: userID, Amount: 300, Status: model.HoldAuthorized}, nil).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 500, Held: 300}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 500 && a.Held == 0
+		})).Return(nil).Once()
+		holdRepo.On("Store", mock.MatchedBy(func(h model.Hold) bool {
+			return h.OrderID == orderID && h.Status == model.HoldVoided
+		})).Return(nil).Once()
+		pendingRepo.On("Delete", orderID).Return(nil).Once()
+
+		err := service.Void(orderID)
+		assert.NoError(t, err)
+		holdRepo.AssertExpectations(t)
+		pendingRepo.AssertExpectations(t)
+	})
+
+	t.Run("void_captured", func(t *testing.T) {
+		holdRepo.On("Find", orderID).Return(&model.Hold{OrderID: orderID, UserID: userID, Amount: 300, Status: model.HoldCaptured}, nil).Once()
+		pendingRepo.On("Delete", orderID).Return(nil).Once()
+		storeCalls := len(repo.Calls)
+
+		err := service.Void(orderID)
+		assert.NoError(t, err)
+		assert.Len(t, repo.Calls, storeCalls)
+	})
+
+	t.Run("void_without_hold_drops_pending_payment", func(t *testing.T) {
+		otherOrderID := uuid.New()
+		holdRepo.On("Find", otherOrderID).Return(nil, model.ErrHoldNotFound).Once()
+		pendingRepo.On("Delete", otherOrderID).Return(nil).Once()
+
+		err := service.Void(otherOrderID)
+		assert.NoError(t, err)
+		pendingRepo.AssertExpectations(t)
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		expiredOrderID := uuid.New()
+		holdRepo.On("Find", expiredOrderID).Return(&model.Hold{OrderID: expiredOrderID, UserID: userID, Amount: 300, Status: model.HoldAuthorized}, nil).Once()
+		repo.On("Find", model.FindSpec{UserID: &userID}).Return(&model.Account{UserID: userID, Balance: 500, Held: 300}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(a model.Account) bool {
+			return a.Balance == 500 && a.Held == 0
+		})).Return(nil).Once()
+		holdRepo.On("Store", mock.MatchedBy(func(h model.Hold) bool {
+			return h.OrderID == expiredOrderID && h.Status == model.HoldExpired
+		})).Return(nil).Once()
+		pendingRepo.On("Delete", expiredOrderID).Return(nil).Once()
+
+		err := service.Expire(expiredOrderID)
+		assert.NoError(t, err)
+		holdRepo.AssertExpectations(t)
+		pendingRepo.AssertExpectations(t)
+	})
+}
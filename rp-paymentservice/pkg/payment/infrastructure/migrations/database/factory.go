@@ -44,4 +44,9 @@ func NewDatabaseMigrator(
 
 var builderFunctions = []MigrationBuilderFunc{
 	NewVersion1722266005,
+	NewVersion1722266011,
+	NewVersion1722266012,
+	NewVersion1722266014,
+	NewVersion1722266022,
+	NewVersion1722266028,
 }
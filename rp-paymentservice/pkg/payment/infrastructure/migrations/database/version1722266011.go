@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266011(client mysql.ClientContext) migrator.Migration {
+	return &version1722266011{
+		client: client,
+	}
+}
+
+type version1722266011 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266011) Version() int64 {
+	return 1722266011
+}
+
+func (v version1722266011) Description() string {
+	return "Create 'payment_transaction' table"
+}
+
+func (v version1722266011) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE payment_transaction
+		(
+			transaction_id VARCHAR(64)  NOT NULL,
+			user_id        VARCHAR(64)  NOT NULL,
+			type           INT          NOT NULL,
+			amount         BIGINT       NOT NULL,
+			balance        BIGINT       NOT NULL,
+			order_id       VARCHAR(64)  NULL,
+			created_at     DATETIME     NOT NULL,
+			PRIMARY KEY (transaction_id),
+			INDEX payment_transaction_user_id_idx (user_id, transaction_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci
+	`)
+	return errors.WithStack(err)
+}
@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266012(client mysql.ClientContext) migrator.Migration {
+	return &version1722266012{
+		client: client,
+	}
+}
+
+type version1722266012 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266012) Version() int64 {
+	return 1722266012
+}
+
+func (v version1722266012) Description() string {
+	return "Add order index to 'payment_transaction' table"
+}
+
+func (v version1722266012) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE payment_transaction
+			ADD INDEX payment_transaction_order_id_idx (order_id, type)
+	`)
+	return errors.WithStack(err)
+}
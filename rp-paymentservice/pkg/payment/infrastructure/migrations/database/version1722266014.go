@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266014(client mysql.ClientContext) migrator.Migration {
+	return &version1722266014{
+		client: client,
+	}
+}
+
+type version1722266014 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266014) Version() int64 {
+	return 1722266014
+}
+
+func (v version1722266014) Description() string {
+	return "Create 'pending_payment' table"
+}
+
+func (v version1722266014) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE pending_payment
+		(
+			order_id      VARCHAR(64)  NOT NULL,
+			user_id       VARCHAR(64)  NOT NULL,
+			amount        BIGINT       NOT NULL,
+			created_at    DATETIME     NOT NULL,
+			PRIMARY KEY (order_id),
+			INDEX pending_payment_user_id_idx (user_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci
+	`)
+	return errors.WithStack(err)
+}
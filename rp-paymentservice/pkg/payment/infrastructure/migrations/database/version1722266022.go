@@ -0,0 +1,36 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266022(client mysql.ClientContext) migrator.Migration {
+	return &version1722266022{
+		client: client,
+	}
+}
+
+type version1722266022 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266022) Version() int64 {
+	return 1722266022
+}
+
+func (v version1722266022) Description() string {
+	return "Add 'return_id' to 'payment_transaction' table"
+}
+
+func (v version1722266022) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE payment_transaction
+			ADD COLUMN return_id VARCHAR(64) NULL AFTER order_id,
+			ADD UNIQUE INDEX payment_transaction_return_id_idx (return_id)
+	`)
+	return errors.WithStack(err)
+}
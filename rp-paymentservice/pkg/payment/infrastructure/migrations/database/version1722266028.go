@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266028(client mysql.ClientContext) migrator.Migration {
+	return &version1722266028{
+		client: client,
+	}
+}
+
+type version1722266028 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266028) Version() int64 {
+	return 1722266028
+}
+
+func (v version1722266028) Description() string {
+	return "Create 'payment_hold' table and add 'held' to 'account' table"
+}
+
+func (v version1722266028) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE payment_hold
+		(
+			order_id      VARCHAR(64)  NOT NULL,
+			user_id       VARCHAR(64)  NOT NULL,
+			amount        BIGINT       NOT NULL,
+			status        INT          NOT NULL,
+			expires_at    DATETIME     NOT NULL,
+			created_at    DATETIME     NOT NULL,
+			updated_at    DATETIME     NOT NULL,
+			PRIMARY KEY (order_id),
+			INDEX payment_hold_user_id_idx (user_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		ALTER TABLE account
+			ADD COLUMN held BIGINT NOT NULL DEFAULT 0 AFTER balance
+	`)
+	return errors.WithStack(err)
+}
@@ -38,12 +38,13 @@ func (p *accountQueryService) FindUserBalance(ctx context.Context, userID uuid.U
 	account := struct {
 		UserID  uuid.UUID `db:"user_id"`
 		Balance int64     `db:"balance"`
+		Held    int64     `db:"held"`
 	}{}
 
 	err = p.client.GetContext(
 		ctx,
 		&account,
-		`SELECT user_id, balance FROM account WHERE user_id = ?`,
+		`SELECT user_id, balance, held FROM account WHERE user_id = ?`,
 		userID,
 	)
 	if err != nil {
@@ -54,7 +55,81 @@ func (p *accountQueryService) FindUserBalance(ctx context.Context, userID uuid.U
 	}
 
 	return &appmodel.UserBalance{
-		UserID:  account.UserID,
-		Balance: account.Balance,
+		UserID:    account.UserID,
+		Balance:   account.Balance,
+		Available: account.Balance - account.Held,
 	}, nil
 }
+
+const (
+	defaultTransactionsPageSize = 20
+	maxTransactionsPageSize     = 100
+)
+
+func (p *accountQueryService) ListTransactions(ctx context.Context, spec appmodel.ListTransactions) (_ appmodel.TransactionsPage, err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+		metrics.DatabaseDuration.WithLabelValues("list_query", "payment_transaction", status).Observe(time.Since(start).Seconds())
+	}()
+
+	pageSize := spec.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultTransactionsPageSize
+	}
+	if pageSize > maxTransactionsPageSize {
+		pageSize = maxTransactionsPageSize
+	}
+
+	// UUIDv7 монотонно растут, поэтому идентификатор служит и порядком, и курсором
+	query := `SELECT transaction_id, user_id, type, amount, balance, order_id, created_at FROM payment_transaction WHERE user_id = ?`
+	args := []interface{}{spec.UserID}
+	if spec.AfterID != nil {
+		query += ` AND transaction_id < ?`
+		args = append(args, *spec.AfterID)
+	}
+	query += ` ORDER BY transaction_id DESC LIMIT ?`
+	args = append(args, pageSize+1)
+
+	var rows []struct {
+		TransactionID uuid.UUID     `db:"transaction_id"`
+		UserID        uuid.UUID     `db:"user_id"`
+		Type          int           `db:"type"`
+		Amount        int64         `db:"amount"`
+		Balance       int64         `db:"balance"`
+		OrderID       uuid.NullUUID `db:"order_id"`
+		CreatedAt     time.Time     `db:"created_at"`
+	}
+	err = p.client.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return appmodel.TransactionsPage{}, errors.WithStack(err)
+	}
+
+	var page appmodel.TransactionsPage
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextAfterID := rows[pageSize-1].TransactionID
+		page.NextAfterID = &nextAfterID
+	}
+
+	page.Transactions = make([]appmodel.Transaction, 0, len(rows))
+	for _, row := range rows {
+		transaction := appmodel.Transaction{
+			TransactionID: row.TransactionID,
+			UserID:        row.UserID,
+			Type:          row.Type,
+			Amount:        row.Amount,
+			Balance:       row.Balance,
+			CreatedAt:     row.CreatedAt,
+		}
+		if row.OrderID.Valid {
+			orderID := row.OrderID.UUID
+			transaction.OrderID = &orderID
+		}
+		page.Transactions = append(page.Transactions, transaction)
+	}
+	return page, nil
+}
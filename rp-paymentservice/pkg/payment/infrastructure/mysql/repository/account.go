@@ -47,13 +47,15 @@ func (p *accountRepository) Store(account model.Account) (err error) {
 
 	_, err = p.client.ExecContext(p.ctx,
 		`
-	INSERT INTO account (user_id, balance, created_at, updated_at) VALUES (?, ?, ?, ?) AS new
+	INSERT INTO account (user_id, balance, held, created_at, updated_at) VALUES (?, ?, ?, ?, ?) AS new
 	ON DUPLICATE KEY UPDATE
 		balance = new.balance,
+		held = new.held,
 	    updated_at = new.updated_at
 	`,
 		account.UserID,
 		account.Balance,
+		account.Held,
 		account.CreatedAt,
 		account.UpdatedAt,
 	)
@@ -73,6 +75,7 @@ func (p *accountRepository) Find(spec model.FindSpec) (_ *model.Account, err err
 	account := struct {
 		UserID    uuid.UUID `db:"user_id"`
 		Balance   int64     `db:"balance"`
+		Held      int64     `db:"held"`
 		CreatedAt time.Time `db:"created_at"`
 		UpdatedAt time.Time `db:"updated_at"`
 	}{}
@@ -81,7 +84,7 @@ func (p *accountRepository) Find(spec model.FindSpec) (_ *model.Account, err err
 	err = p.client.GetContext(
 		p.ctx,
 		&account,
-		`SELECT user_id, balance, created_at, updated_at FROM account WHERE `+query,
+		`SELECT user_id, balance, held, created_at, updated_at FROM account WHERE `+query,
 		args...,
 	)
 	if err != nil {
@@ -94,6 +97,7 @@ func (p *accountRepository) Find(spec model.FindSpec) (_ *model.Account, err err
 	return &model.Account{
 		UserID:    account.UserID,
 		Balance:   account.Balance,
+		Held:      account.Held,
 		CreatedAt: account.CreatedAt,
 		UpdatedAt: account.UpdatedAt,
 	}, nil
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"paymentservice/pkg/payment/domain/model"
+	"paymentservice/pkg/payment/infrastructure/metrics"
+)
+
+func NewHoldRepository(ctx context.Context, client mysql.ClientContext) model.HoldRepository {
+	return &holdRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type holdRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *holdRepository) Store(hold model.Hold) (err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "payment_hold", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`
+	INSERT INTO payment_hold (order_id, user_id, amount, status, expires_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?) AS new
+	ON DUPLICATE KEY UPDATE
+		status = new.status,
+		updated_at = new.updated_at
+	`,
+		hold.OrderID,
+		hold.UserID,
+		hold.Amount,
+		hold.Status,
+		hold.ExpiresAt,
+		hold.CreatedAt,
+		hold.UpdatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *holdRepository) Find(orderID uuid.UUID) (_ *model.Hold, err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil && !errors.Is(err, model.ErrHoldNotFound) {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "payment_hold", status).Observe(time.Since(start).Seconds())
+	}()
+
+	hold := struct {
+		OrderID   uuid.UUID `db:"order_id"`
+		UserID    uuid.UUID `db:"user_id"`
+		Amount    int64     `db:"amount"`
+		Status    int       `db:"status"`
+		ExpiresAt time.Time `db:"expires_at"`
+		CreatedAt time.Time `db:"created_at"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}{}
+	err = r.client.GetContext(
+		r.ctx,
+		&hold,
+		`SELECT order_id, user_id, amount, status, expires_at, created_at, updated_at FROM payment_hold WHERE order_id = ?`,
+		orderID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrHoldNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.Hold{
+		OrderID:   hold.OrderID,
+		UserID:    hold.UserID,
+		Amount:    hold.Amount,
+		Status:    model.HoldStatus(hold.Status),
+		ExpiresAt: hold.ExpiresAt,
+		CreatedAt: hold.CreatedAt,
+		UpdatedAt: hold.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"paymentservice/pkg/payment/domain/model"
+	"paymentservice/pkg/payment/infrastructure/metrics"
+)
+
+func NewPendingPaymentRepository(ctx context.Context, client mysql.ClientContext) model.PendingPaymentRepository {
+	return &pendingPaymentRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type pendingPaymentRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *pendingPaymentRepository) Store(payment model.PendingPayment) (err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "pending_payment", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`
+	INSERT INTO pending_payment (order_id, user_id, amount, created_at) VALUES (?, ?, ?, ?) AS new
+	ON DUPLICATE KEY UPDATE
+		amount = new.amount
+	`,
+		payment.OrderID,
+		payment.UserID,
+		payment.Amount,
+		payment.CreatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *pendingPaymentRepository) FindForUser(userID uuid.UUID) (_ []model.PendingPayment, err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_for_user", "pending_payment", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var rows []struct {
+		OrderID   uuid.UUID `db:"order_id"`
+		UserID    uuid.UUID `db:"user_id"`
+		Amount    int64     `db:"amount"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err = r.client.SelectContext(r.ctx, &rows,
+		`SELECT order_id, user_id, amount, created_at FROM pending_payment WHERE user_id = ? ORDER BY created_at`,
+		userID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	payments := make([]model.PendingPayment, len(rows))
+	for i, row := range rows {
+		payments[i] = model.PendingPayment{
+			OrderID:   row.OrderID,
+			UserID:    row.UserID,
+			Amount:    row.Amount,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return payments, nil
+}
+
+func (r *pendingPaymentRepository) Delete(orderID uuid.UUID) (err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("delete", "pending_payment", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx, `DELETE FROM pending_payment WHERE order_id = ?`, orderID)
+	return errors.WithStack(err)
+}
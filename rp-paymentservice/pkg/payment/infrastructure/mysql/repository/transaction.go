@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"paymentservice/pkg/payment/domain/model"
+	"paymentservice/pkg/payment/infrastructure/metrics"
+)
+
+func NewTransactionRepository(ctx context.Context, client mysql.ClientContext) model.TransactionRepository {
+	return &transactionRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type transactionRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *transactionRepository) NextID() (uuid.UUID, error) {
+	return uuid.NewV7()
+}
+
+// Store только добавляет запись: журнал операций не редактируется
+func (r *transactionRepository) Store(transaction model.Transaction) (err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "payment_transaction", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`
+	INSERT INTO payment_transaction (transaction_id, user_id, type, amount, balance, order_id, return_id, created_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		transaction.TransactionID,
+		transaction.UserID,
+		transaction.Type,
+		transaction.Amount,
+		transaction.Balance,
+		transaction.OrderID,
+		transaction.ReturnID,
+		transaction.CreatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *transactionRepository) FindForOrder(orderID uuid.UUID, transactionType model.TransactionType) (_ *model.Transaction, err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil && !errors.Is(err, model.ErrTransactionNotFound) {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_for_order", "payment_transaction", status).Observe(time.Since(start).Seconds())
+	}()
+
+	transaction := struct {
+		TransactionID uuid.UUID `db:"transaction_id"`
+		UserID        uuid.UUID `db:"user_id"`
+		Type          int       `db:"type"`
+		Amount        int64     `db:"amount"`
+		Balance       int64     `db:"balance"`
+		CreatedAt     time.Time `db:"created_at"`
+	}{}
+
+	err = r.client.GetContext(
+		r.ctx,
+		&transaction,
+		`SELECT transaction_id, user_id, type, amount, balance, created_at FROM payment_transaction
+		WHERE order_id = ? AND type = ? AND return_id IS NULL ORDER BY transaction_id LIMIT 1`,
+		orderID,
+		transactionType,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrTransactionNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.Transaction{
+		TransactionID: transaction.TransactionID,
+		UserID:        transaction.UserID,
+		Type:          model.TransactionType(transaction.Type),
+		Amount:        transaction.Amount,
+		Balance:       transaction.Balance,
+		OrderID:       &orderID,
+		CreatedAt:     transaction.CreatedAt,
+	}, nil
+}
+
+func (r *transactionRepository) FindForReturn(returnID uuid.UUID) (_ *model.Transaction, err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil && !errors.Is(err, model.ErrTransactionNotFound) {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("find_for_return", "payment_transaction", status).Observe(time.Since(start).Seconds())
+	}()
+
+	transaction := struct {
+		TransactionID uuid.UUID `db:"transaction_id"`
+		UserID        uuid.UUID `db:"user_id"`
+		Type          int       `db:"type"`
+		Amount        int64     `db:"amount"`
+		Balance       int64     `db:"balance"`
+		OrderID       uuid.UUID `db:"order_id"`
+		CreatedAt     time.Time `db:"created_at"`
+	}{}
+
+	err = r.client.GetContext(
+		r.ctx,
+		&transaction,
+		`SELECT transaction_id, user_id, type, amount, balance, order_id, created_at FROM payment_transaction
+		WHERE return_id = ? LIMIT 1`,
+		returnID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(model.ErrTransactionNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &model.Transaction{
+		TransactionID: transaction.TransactionID,
+		UserID:        transaction.UserID,
+		Type:          model.TransactionType(transaction.Type),
+		Amount:        transaction.Amount,
+		Balance:       transaction.Balance,
+		OrderID:       &transaction.OrderID,
+		ReturnID:      &returnID,
+		CreatedAt:     transaction.CreatedAt,
+	}, nil
+}
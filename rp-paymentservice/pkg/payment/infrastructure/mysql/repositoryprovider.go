@@ -21,3 +21,15 @@ type repositoryProvider struct {
 func (r *repositoryProvider) AccountRepository(ctx context.Context) model.AccountRepository {
 	return repository.NewAccountRepository(ctx, r.client)
 }
+
+func (r *repositoryProvider) TransactionRepository(ctx context.Context) model.TransactionRepository {
+	return repository.NewTransactionRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) PendingPaymentRepository(ctx context.Context) model.PendingPaymentRepository {
+	return repository.NewPendingPaymentRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) HoldRepository(ctx context.Context) model.HoldRepository {
+	return repository.NewHoldRepository(ctx, r.client)
+}
@@ -3,10 +3,21 @@ package activity
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
 
 	"paymentservice/pkg/payment/application/service"
+	"paymentservice/pkg/payment/domain/model"
+)
+
+const (
+	// insufficientFundsErrorType - тип ошибки, по которому сага orderservice узнает нехватку денег
+	insufficientFundsErrorType = "InsufficientFunds"
+	// holdNotAuthorizedErrorType - холда нет или он уже отпущен, списывать нечего
+	holdNotAuthorizedErrorType = "HoldNotAuthorized"
 )
 
 func NewPaymentActivities(accountService service.AccountService) *PaymentActivities {
@@ -17,16 +28,24 @@ type PaymentActivities struct {
 	accountService service.AccountService
 }
 
-func (a *PaymentActivities) ProcessPayment(ctx context.Context, userIDStr string, amount int64) (bool, error) {
+func (a *PaymentActivities) ProcessPayment(ctx context.Context, userIDStr, orderIDStr string, amount int64) (bool, error) {
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		return false, err
 	}
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
 
-	fmt.Printf("Attempting to charge user %s amount %d\n", userIDStr, amount)
-	err = a.accountService.Charge(ctx, userID, amount)
+	fmt.Printf("Attempting to charge user %s amount %d for order %s\n", userIDStr, amount, orderIDStr)
+	err = a.accountService.Charge(ctx, userID, orderID, amount)
 	if err != nil {
 		fmt.Printf("Charge failed: %v\n", err)
+		if errors.Is(err, model.ErrInsufficientFunds) {
+			// повторять бессмысленно: сага заказа сама решит, ждать ли пополнения
+			return false, temporal.NewNonRetryableApplicationError(err.Error(), insufficientFundsErrorType, err)
+		}
 		return false, err
 	}
 
@@ -34,16 +53,130 @@ func (a *PaymentActivities) ProcessPayment(ctx context.Context, userIDStr string
 	return true, nil
 }
 
-func (a *PaymentActivities) RefundPayment(ctx context.Context, userIDStr string, amount int64) (bool, error) {
+func (a *PaymentActivities) RefundPayment(ctx context.Context, userIDStr, orderIDStr string, amount int64) (bool, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return false, err
+	}
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("Refund user %s amount %d for order %s\n", userIDStr, amount, orderIDStr)
+	err = a.accountService.Refund(ctx, userID, orderID, amount)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RefundReturn возвращает деньги за часть заказа, оформленную возвратом
+func (a *PaymentActivities) RefundReturn(ctx context.Context, userIDStr, orderIDStr, returnIDStr string, amount int64) (bool, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return false, err
+	}
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
+	returnID, err := uuid.Parse(returnIDStr)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("Refund user %s amount %d for return %s of order %s\n", userIDStr, amount, returnIDStr, orderIDStr)
+	err = a.accountService.RefundReturn(ctx, userID, orderID, returnID, amount)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AuthorizePayment откладывает деньги под заказ на holdTTL. Нехватку денег сага обрабатывает так же, как в ProcessPayment
+func (a *PaymentActivities) AuthorizePayment(ctx context.Context, userIDStr, orderIDStr string, amount int64, holdTTL time.Duration) (bool, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return false, err
+	}
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("Authorize user %s amount %d for order %s\n", userIDStr, amount, orderIDStr)
+	err = a.accountService.Authorize(ctx, userID, orderID, amount, holdTTL)
+	if err != nil {
+		if errors.Is(err, model.ErrInsufficientFunds) {
+			return false, temporal.NewNonRetryableApplicationError(err.Error(), insufficientFundsErrorType, err)
+		}
+		// холд по заказу уже отпущен: повтор не поможет, а считать деньги отложенными нельзя
+		if errors.Is(err, model.ErrHoldNotAuthorized) {
+			return false, temporal.NewNonRetryableApplicationError(err.Error(), holdNotAuthorizedErrorType, err)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// AwaitBalanceTopUp заводит ожидание пополнения на время окна оплаты заказа
+func (a *PaymentActivities) AwaitBalanceTopUp(ctx context.Context, userIDStr, orderIDStr string, amount int64) (bool, error) {
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		return false, err
 	}
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
 
-	fmt.Printf("Refund user %s amount %d\n", userIDStr, amount)
-	err = a.accountService.Refund(ctx, userID, amount)
+	fmt.Printf("Await top up of user %s for order %s\n", userIDStr, orderIDStr)
+	err = a.accountService.AwaitTopUp(ctx, userID, orderID, amount)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
+
+func (a *PaymentActivities) CapturePayment(ctx context.Context, orderIDStr string) (bool, error) {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("Capture hold for order %s\n", orderIDStr)
+	err = a.accountService.Capture(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, model.ErrHoldNotAuthorized) || errors.Is(err, model.ErrHoldNotFound) {
+			return false, temporal.NewNonRetryableApplicationError(err.Error(), holdNotAuthorizedErrorType, err)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *PaymentActivities) VoidPayment(ctx context.Context, orderIDStr string) (bool, error) {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Printf("Void hold for order %s\n", orderIDStr)
+	err = a.accountService.Void(ctx, orderID)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExpireHold вызывается таймером холда из HoldExpiryWorkflow
+func (a *PaymentActivities) ExpireHold(ctx context.Context, orderIDStr string) error {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Expire hold for order %s\n", orderIDStr)
+	return a.accountService.ExpireHold(ctx, orderID)
+}
@@ -0,0 +1,46 @@
+package workflowoutbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
+	"github.com/pkg/errors"
+
+	appmodel "paymentservice/pkg/payment/application/model"
+)
+
+func NewCommandSerializer() outbox.EventSerializer[outbox.Event] {
+	return &commandSerializer{}
+}
+
+type commandSerializer struct{}
+
+func (s commandSerializer) Serialize(event outbox.Event) (string, error) {
+	switch e := event.(type) {
+	case *appmodel.NotifyBalanceToppedUp:
+		b, err := json.Marshal(BalanceToppedUp{
+			OrderID: e.OrderID.String(),
+			UserID:  e.UserID.String(),
+		})
+		return string(b), errors.WithStack(err)
+	case *appmodel.StartHoldExpiryWorkflow:
+		b, err := json.Marshal(HoldExpiry{
+			OrderID:   e.OrderID.String(),
+			ExpiresAt: e.ExpiresAt,
+		})
+		return string(b), errors.WithStack(err)
+	default:
+		return "", errors.Errorf("unknown workflow command %q", event.Type())
+	}
+}
+
+type BalanceToppedUp struct {
+	OrderID string `json:"order_id"`
+	UserID  string `json:"user_id"`
+}
+
+type HoldExpiry struct {
+	OrderID   string    `json:"order_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,105 @@
+package workflowoutbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/application/logging"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/outbox"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+
+	appmodel "paymentservice/pkg/payment/application/model"
+	"paymentservice/pkg/payment/infrastructure/temporal/workflows"
+)
+
+// TransportName - отдельный outbox для сигналов в саги orderservice и запуска своих таймеров
+const TransportName = "workflow"
+
+const (
+	// имена совпадают с orderservice: workflow заказа и сигнал о пополнении баланса
+	orderWorkflowIDPrefix = "order_"
+	balanceToppedUpSignal = "balance_topped_up"
+)
+
+type WorkflowClient interface {
+	SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error
+	ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow interface{}, args ...interface{}) (client.WorkflowRun, error)
+}
+
+func NewTransport(logger logging.Logger, workflowClient WorkflowClient) outbox.Transport {
+	return &transport{
+		logger:         logger,
+		workflowClient: workflowClient,
+	}
+}
+
+type transport struct {
+	logger         logging.Logger
+	workflowClient WorkflowClient
+}
+
+func (t *transport) HandleEvents(ctx context.Context, correlationID, eventType, payload string) error {
+	l := t.logger.WithFields(logging.Fields{
+		"correlationID": correlationID,
+		"eventType":     eventType,
+		"payload":       payload,
+	})
+
+	var err error
+	switch eventType {
+	case appmodel.NotifyBalanceToppedUp{}.Type():
+		err = t.signalBalanceToppedUp(ctx, payload)
+	case appmodel.StartHoldExpiryWorkflow{}.Type():
+		err = t.startHoldExpiryWorkflow(ctx, payload)
+	default:
+		err = errors.Errorf("unknown workflow command %q", eventType)
+	}
+	if err != nil {
+		l.Error(err, "failed to handle workflow command")
+		return err
+	}
+	l.Info("successfully handled workflow command")
+	return nil
+}
+
+func (t *transport) signalBalanceToppedUp(ctx context.Context, payload string) error {
+	var command BalanceToppedUp
+	err := json.Unmarshal([]byte(payload), &command)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = t.workflowClient.SignalWorkflow(ctx, orderWorkflowIDPrefix+command.OrderID, "", balanceToppedUpSignal, nil)
+	// сага уже завершилась (окно оплаты истекло или заказ отменен) - будить некого
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+func (t *transport) startHoldExpiryWorkflow(ctx context.Context, payload string) error {
+	var command HoldExpiry
+	err := json.Unmarshal([]byte(payload), &command)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// outbox доставляет команду минимум один раз: на один холд - один таймер
+	_, err = t.workflowClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    workflows.HoldExpiryWorkflowID(command.OrderID),
+		TaskQueue:             workflows.PaymentTaskQueue,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, workflows.HoldExpiryWorkflow, workflows.HoldExpiryParams{
+		OrderID:   command.OrderID,
+		ExpiresAt: command.ExpiresAt,
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	return err
+}
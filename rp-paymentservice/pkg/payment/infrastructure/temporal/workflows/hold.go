@@ -0,0 +1,47 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// PaymentTaskQueue - очередь воркера paymentservice
+const PaymentTaskQueue = "paymentservice_task_queue"
+
+type HoldExpiryParams struct {
+	OrderID   string
+	ExpiresAt time.Time
+}
+
+func HoldExpiryWorkflowID(orderID string) string {
+	return "payment_hold_" + orderID
+}
+
+// HoldExpiryWorkflow отпускает холд, когда истекает его срок. Если холд уже списан или снят,
+// ExpireHold ничего не делает
+func HoldExpiryWorkflow(ctx workflow.Context, params HoldExpiryParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting HoldExpiryWorkflow", "OrderID", params.OrderID, "ExpiresAt", params.ExpiresAt)
+
+	err := workflow.Sleep(ctx, params.ExpiresAt.Sub(workflow.Now(ctx)))
+	if err != nil {
+		return err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	})
+	err = workflow.ExecuteActivity(ctx, "ExpireHold", params.OrderID).Get(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to expire hold", "OrderID", params.OrderID, "Error", err)
+		return err
+	}
+
+	logger.Info("Hold expired", "OrderID", params.OrderID)
+	return nil
+}
@@ -2,6 +2,7 @@ package transport
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -61,8 +62,98 @@ func (p *paymentInternalAPI) FindUserBalance(ctx context.Context, request *payme
 	}
 	return &paymentinternal.FindUserBalanceResponse{
 		Balance: &paymentinternal.UserBalance{
-			UserID:  balance.UserID.String(),
-			Balance: balance.Balance,
+			UserID:    balance.UserID.String(),
+			Balance:   balance.Balance,
+			Available: balance.Available,
 		},
 	}, nil
 }
+
+func (p *paymentInternalAPI) ListTransactions(ctx context.Context, request *paymentinternal.ListTransactionsRequest) (*paymentinternal.ListTransactionsResponse, error) {
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	spec := appmodel.ListTransactions{
+		UserID:   userID,
+		PageSize: int(request.PageSize),
+	}
+	if request.PageToken != "" {
+		afterID, err2 := uuid.Parse(request.PageToken)
+		if err2 != nil {
+			return nil, err2
+		}
+		spec.AfterID = &afterID
+	}
+
+	page, err := p.accountQueryService.ListTransactions(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &paymentinternal.ListTransactionsResponse{
+		Transactions: make([]*paymentinternal.Transaction, 0, len(page.Transactions)),
+	}
+	for _, transaction := range page.Transactions {
+		t := &paymentinternal.Transaction{
+			TransactionID: transaction.TransactionID.String(),
+			UserID:        transaction.UserID.String(),
+			Type:          paymentinternal.TransactionType(transaction.Type),
+			Amount:        transaction.Amount,
+			Balance:       transaction.Balance,
+			CreatedAt:     transaction.CreatedAt.Unix(),
+		}
+		if transaction.OrderID != nil {
+			orderID := transaction.OrderID.String()
+			t.OrderID = &orderID
+		}
+		response.Transactions = append(response.Transactions, t)
+	}
+	if page.NextAfterID != nil {
+		response.NextPageToken = page.NextAfterID.String()
+	}
+	return response, nil
+}
+
+func (p *paymentInternalAPI) Authorize(ctx context.Context, request *paymentinternal.AuthorizeRequest) (*paymentinternal.AuthorizeResponse, error) {
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.accountService.Authorize(ctx, userID, orderID, request.Amount, time.Duration(request.HoldTTLSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentinternal.AuthorizeResponse{}, nil
+}
+
+func (p *paymentInternalAPI) Capture(ctx context.Context, request *paymentinternal.CaptureRequest) (*paymentinternal.CaptureResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.accountService.Capture(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentinternal.CaptureResponse{}, nil
+}
+
+func (p *paymentInternalAPI) Void(ctx context.Context, request *paymentinternal.VoidRequest) (*paymentinternal.VoidResponse, error) {
+	orderID, err := uuid.Parse(request.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.accountService.Void(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentinternal.VoidResponse{}, nil
+}
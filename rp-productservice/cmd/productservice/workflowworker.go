@@ -6,13 +6,17 @@ import (
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/logging"
 	libio "gitea.xscloud.ru/xscloud/golib/pkg/common/io"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/outbox"
 	"github.com/gorilla/mux"
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 	"golang.org/x/sync/errgroup"
 
-	"productservice/pkg/product/infrastructure/mysql/query"
+	appservice "productservice/pkg/product/application/service"
+	"productservice/pkg/product/infrastructure/integrationevent"
+	inframysql "productservice/pkg/product/infrastructure/mysql"
 	"productservice/pkg/product/infrastructure/temporal/activity"
 )
 
@@ -42,6 +46,7 @@ func workflowWorker(logger logging.Logger) *cli.Command {
 				return err
 			}
 			closer.AddCloser(databaseConnector)
+			databaseConnectionPool := mysql.NewConnectionPool(databaseConnector.TransactionalClient())
 
 			temporalClient, err := client.Dial(client.Options{
 				HostPort: cnf.Temporal.Host,
@@ -54,11 +59,17 @@ func workflowWorker(logger logging.Logger) *cli.Command {
 				return nil
 			}))
 
-			productQueryService := query.NewProductQueryService(databaseConnector.TransactionalClient())
+			libUoW := mysql.NewUnitOfWork(databaseConnectionPool, inframysql.NewRepositoryProvider)
+			libLUow := mysql.NewLockableUnitOfWork(libUoW, mysql.NewLocker(databaseConnectionPool))
+			uow := inframysql.NewUnitOfWork(libUoW)
+			luow := inframysql.NewLockableUnitOfWork(libLUow)
+			eventDispatcher := outbox.NewEventDispatcher(appID, integrationevent.TransportName, integrationevent.NewEventSerializer(), libUoW)
+
+			productService := appservice.NewProductService(uow, luow, eventDispatcher)
 
 			w := worker.New(temporalClient, "productservice_task_queue", worker.Options{})
 
-			activities := activity.NewProductActivities(productQueryService)
+			activities := activity.NewProductActivities(productService)
 			w.RegisterActivity(activities)
 
 			errGroup := errgroup.Group{}
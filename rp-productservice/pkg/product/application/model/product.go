@@ -7,4 +7,11 @@ type Product struct {
 	Name        string
 	Price       int64
 	Description *string
+	Stock       int
+	Version     int64
+}
+
+type ReservationItem struct {
+	ProductID uuid.UUID
+	Quantity  int
 }
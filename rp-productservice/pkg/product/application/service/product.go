@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"gitea.xscloud.ru/xscloud/golib/pkg/application/outbox"
 	"github.com/google/uuid"
@@ -15,6 +16,11 @@ import (
 
 type ProductService interface {
 	StoreProduct(ctx context.Context, product appmodel.Product) (uuid.UUID, error)
+	SetStock(ctx context.Context, productID uuid.UUID, stock int) error
+	AdjustStock(ctx context.Context, productID uuid.UUID, delta int) (int, error)
+	ReserveProducts(ctx context.Context, orderID uuid.UUID, items []appmodel.ReservationItem) error
+	ReleaseProducts(ctx context.Context, orderID uuid.UUID) error
+	RestockProducts(ctx context.Context, returnID uuid.UUID, items []appmodel.ReservationItem) error
 }
 
 func NewProductService(
@@ -63,6 +69,85 @@ func (s *productService) StoreProduct(ctx context.Context, product appmodel.Prod
 	return productID, err
 }
 
+func (s *productService) SetStock(ctx context.Context, productID uuid.UUID, stock int) error {
+	return s.luow.Execute(ctx, []string{productLock(productID)}, func(provider RepositoryProvider) error {
+		return s.inventoryService(ctx, provider).SetStock(productID, stock)
+	})
+}
+
+func (s *productService) AdjustStock(ctx context.Context, productID uuid.UUID, delta int) (int, error) {
+	var stock int
+	err := s.luow.Execute(ctx, []string{productLock(productID)}, func(provider RepositoryProvider) error {
+		var err error
+		stock, err = s.inventoryService(ctx, provider).AdjustStock(productID, delta)
+		return err
+	})
+	return stock, err
+}
+
+func (s *productService) ReserveProducts(ctx context.Context, orderID uuid.UUID, items []appmodel.ReservationItem) error {
+	productIDs := make([]uuid.UUID, 0, len(items))
+	domainItems := make([]model.ReservationItem, len(items))
+	for i, item := range items {
+		productIDs = append(productIDs, item.ProductID)
+		domainItems[i] = model.ReservationItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	return s.luow.Execute(ctx, reservationLocks(orderID, productIDs), func(provider RepositoryProvider) error {
+		return s.inventoryService(ctx, provider).ReserveProducts(orderID, domainItems)
+	})
+}
+
+func (s *productService) ReleaseProducts(ctx context.Context, orderID uuid.UUID) error {
+	// набор товаров резерва не меняется после создания, поэтому его можно прочитать до захвата блокировок
+	var productIDs []uuid.UUID
+	err := s.uow.Execute(ctx, func(provider RepositoryProvider) error {
+		reservations, err := provider.ProductReservationRepository(ctx).FindForOrder(orderID)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range reservations {
+			productIDs = append(productIDs, reservation.ProductID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.luow.Execute(ctx, reservationLocks(orderID, productIDs), func(provider RepositoryProvider) error {
+		return s.inventoryService(ctx, provider).ReleaseProducts(orderID)
+	})
+}
+
+func (s *productService) RestockProducts(ctx context.Context, returnID uuid.UUID, items []appmodel.ReservationItem) error {
+	productIDs := make([]uuid.UUID, 0, len(items))
+	domainItems := make([]model.ReservationItem, len(items))
+	for i, item := range items {
+		productIDs = append(productIDs, item.ProductID)
+		domainItems[i] = model.ReservationItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	return s.luow.Execute(ctx, restockLocks(returnID, productIDs), func(provider RepositoryProvider) error {
+		return s.inventoryService(ctx, provider).RestockProducts(returnID, domainItems)
+	})
+}
+
+func (s *productService) inventoryService(ctx context.Context, provider RepositoryProvider) service.InventoryService {
+	return service.NewInventoryService(
+		provider.ProductRepository(ctx),
+		provider.ProductReservationRepository(ctx),
+		provider.ProductRestockRepository(ctx),
+		s.domainEventDispatcher(ctx),
+	)
+}
+
 func (s *productService) domainService(ctx context.Context, repository model.ProductRepository) service.ProductService {
 	return service.NewProductService(repository, s.domainEventDispatcher(ctx))
 }
@@ -80,6 +165,33 @@ func productLock(id uuid.UUID) string {
 	return baseProductLock + id.String()
 }
 
+// reservationLocks возвращает блокировку резерва заказа и блокировки товаров в стабильном порядке,
+// чтобы параллельные резервы с пересекающимися товарами не ловили дедлок
+func reservationLocks(orderID uuid.UUID, productIDs []uuid.UUID) []string {
+	lockNames := make([]string, 0, len(productIDs))
+	for _, productID := range productIDs {
+		lockNames = append(lockNames, productLock(productID))
+	}
+	slices.Sort(lockNames)
+	lockNames = slices.Compact(lockNames)
+	return append([]string{reservationLock(orderID)}, lockNames...)
+}
+
+// restockLocks - то же, что reservationLocks, но первой идет блокировка возврата
+func restockLocks(returnID uuid.UUID, productIDs []uuid.UUID) []string {
+	lockNames := reservationLocks(returnID, productIDs)
+	lockNames[0] = restockLock(returnID)
+	return lockNames
+}
+
+func restockLock(returnID uuid.UUID) string {
+	return fmt.Sprintf("%srestock_%s", baseProductLock, returnID.String())
+}
+
+func reservationLock(orderID uuid.UUID) string {
+	return fmt.Sprintf("%sreservation_%s", baseProductLock, orderID.String())
+}
+
 func productNameLock(name string) string {
 	return fmt.Sprintf("%sname_%s", baseProductLock, name)
 }
@@ -22,6 +22,14 @@ func (m *MockRepositoryProvider) ProductRepository(ctx context.Context) domainmo
 	return m.Called(ctx).Get(0).(domainmodel.ProductRepository)
 }
 
+func (m *MockRepositoryProvider) ProductReservationRepository(ctx context.Context) domainmodel.ProductReservationRepository {
+	return m.Called(ctx).Get(0).(domainmodel.ProductReservationRepository)
+}
+
+func (m *MockRepositoryProvider) ProductRestockRepository(ctx context.Context) domainmodel.ProductRestockRepository {
+	return m.Called(ctx).Get(0).(domainmodel.ProductRestockRepository)
+}
+
 type MockLockableUnitOfWork struct {
 	mock.Mock
 }
@@ -8,6 +8,8 @@ import (
 
 type RepositoryProvider interface {
 	ProductRepository(ctx context.Context) model.ProductRepository
+	ProductReservationRepository(ctx context.Context) model.ProductReservationRepository
+	ProductRestockRepository(ctx context.Context) model.ProductRestockRepository
 }
 
 type LockableUnitOfWork interface {
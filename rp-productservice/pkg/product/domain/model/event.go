@@ -11,6 +11,7 @@ type ProductCreated struct {
 	Name        string
 	Description *string
 	Price       int64
+	Version     int64
 	CreatedAt   time.Time
 }
 
@@ -18,14 +19,15 @@ func (p ProductCreated) Type() string {
 	return "product_created"
 }
 
+// ProductUpdated несет полный снимок карточки после изменения: потребителю не нужно
+// склеивать изменения, достаточно применить снимок с большей версией
 type ProductUpdated struct {
-	ProductID     uuid.UUID
-	UpdatedFields struct {
-		Name        *string
-		Description *string
-		Price       *int64
-	}
-	UpdatedAt time.Time
+	ProductID   uuid.UUID
+	Name        string
+	Description *string
+	Price       int64
+	Version     int64
+	UpdatedAt   time.Time
 }
 
 func (p ProductUpdated) Type() string {
@@ -34,9 +36,22 @@ func (p ProductUpdated) Type() string {
 
 type ProductDeleted struct {
 	ProductID uuid.UUID
+	// Version следует за последним снимком, чтобы запоздавший product_updated не воскресил товар
+	Version   int64
 	DeletedAt time.Time
 }
 
 func (p ProductDeleted) Type() string {
 	return "product_deleted"
 }
+
+type StockChanged struct {
+	ProductID uuid.UUID
+	Stock     int
+	Delta     int
+	ChangedAt time.Time
+}
+
+func (p StockChanged) Type() string {
+	return "stock_changed"
+}
@@ -10,6 +10,8 @@ import (
 var (
 	ErrProductNotFound        = errors.New("product.go not found")
 	ErrProductNameAlreadyUsed = errors.New("product.go name already used")
+	ErrInvalidStock           = errors.New("stock cannot be negative")
+	ErrInsufficientStock      = errors.New("insufficient stock")
 )
 
 type Product struct {
@@ -17,6 +19,8 @@ type Product struct {
 	Name        string
 	Description *string
 	Price       int64 // Цена в копейках
+	Stock       int   // Остаток на складе, уже без зарезервированного под заказы
+	Version     int64 // Растет с каждым изменением карточки товара, по нему потребители упорядочивают события
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidReservationQuantity = errors.New("reservation quantity must be positive")
+
+type ReservationStatus int
+
+const (
+	ReservationActive ReservationStatus = iota
+	ReservationReleased
+)
+
+type ReservationItem struct {
+	ProductID uuid.UUID
+	Quantity  int
+}
+
+type ProductReservation struct {
+	OrderID   uuid.UUID
+	ProductID uuid.UUID
+	Quantity  int
+	Status    ReservationStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ProductReservationRepository interface {
+	Store(reservation ProductReservation) error
+	FindForOrder(orderID uuid.UUID) ([]ProductReservation, error)
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProductRestock - возврат товара на склад по возврату заказа. По нему повторный вызов узнает,
+// что возврат уже оприходован
+type ProductRestock struct {
+	ReturnID  uuid.UUID
+	ProductID uuid.UUID
+	Quantity  int
+	CreatedAt time.Time
+}
+
+type ProductRestockRepository interface {
+	Store(restock ProductRestock) error
+	FindForReturn(returnID uuid.UUID) ([]ProductRestock, error)
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"productservice/pkg/common/domain"
+	"productservice/pkg/product/domain/model"
+)
+
+type InventoryService interface {
+	SetStock(productID uuid.UUID, stock int) error
+	AdjustStock(productID uuid.UUID, delta int) (int, error)
+	ReserveProducts(orderID uuid.UUID, items []model.ReservationItem) error
+	ReleaseProducts(orderID uuid.UUID) error
+	RestockProducts(returnID uuid.UUID, items []model.ReservationItem) error
+}
+
+func NewInventoryService(
+	productRepository model.ProductRepository,
+	reservationRepository model.ProductReservationRepository,
+	restockRepository model.ProductRestockRepository,
+	eventDispatcher domain.EventDispatcher,
+) InventoryService {
+	return &inventoryService{
+		productRepository:     productRepository,
+		reservationRepository: reservationRepository,
+		restockRepository:     restockRepository,
+		eventDispatcher:       eventDispatcher,
+	}
+}
+
+type inventoryService struct {
+	productRepository     model.ProductRepository
+	reservationRepository model.ProductReservationRepository
+	restockRepository     model.ProductRestockRepository
+	eventDispatcher       domain.EventDispatcher
+}
+
+func (s *inventoryService) SetStock(productID uuid.UUID, stock int) error {
+	if stock < 0 {
+		return model.ErrInvalidStock
+	}
+
+	product, err := s.productRepository.Find(model.FindSpec{ProductID: &productID})
+	if err != nil {
+		return err
+	}
+	if product.Stock == stock {
+		return nil
+	}
+
+	return s.changeStock(product, stock-product.Stock)
+}
+
+func (s *inventoryService) AdjustStock(productID uuid.UUID, delta int) (int, error) {
+	product, err := s.productRepository.Find(model.FindSpec{ProductID: &productID})
+	if err != nil {
+		return 0, err
+	}
+	if delta == 0 {
+		return product.Stock, nil
+	}
+	if product.Stock+delta < 0 {
+		return 0, model.ErrInsufficientStock
+	}
+
+	err = s.changeStock(product, delta)
+	if err != nil {
+		return 0, err
+	}
+	return product.Stock, nil
+}
+
+// ReserveProducts списывает остатки под заказ. Повторный вызов для того же заказа ничего не делает,
+// поэтому ретраи Temporal не приводят к двойному резерву
+func (s *inventoryService) ReserveProducts(orderID uuid.UUID, items []model.ReservationItem) error {
+	reservations, err := s.reservationRepository.FindForOrder(orderID)
+	if err != nil {
+		return err
+	}
+	if len(reservations) > 0 {
+		return nil
+	}
+
+	items, err = mergeReservationItems(items)
+	if err != nil {
+		return err
+	}
+
+	currentTime := time.Now()
+	for _, item := range items {
+		product, err := s.productRepository.Find(model.FindSpec{ProductID: &item.ProductID})
+		if err != nil {
+			return err
+		}
+		if product.Stock < item.Quantity {
+			return model.ErrInsufficientStock
+		}
+
+		err = s.changeStock(product, -item.Quantity)
+		if err != nil {
+			return err
+		}
+
+		err = s.reservationRepository.Store(model.ProductReservation{
+			OrderID:   orderID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Status:    model.ReservationActive,
+			CreatedAt: currentTime,
+			UpdatedAt: currentTime,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *inventoryService) ReleaseProducts(orderID uuid.UUID) error {
+	reservations, err := s.reservationRepository.FindForOrder(orderID)
+	if err != nil {
+		return err
+	}
+
+	currentTime := time.Now()
+	for _, reservation := range reservations {
+		if reservation.Status == model.ReservationReleased {
+			continue
+		}
+
+		product, err := s.productRepository.Find(model.FindSpec{ProductID: &reservation.ProductID})
+		if err != nil && !errors.Is(err, model.ErrProductNotFound) {
+			return err
+		}
+		// товар могли удалить, пока заказ был в работе - тогда возвращать остаток некуда
+		if product != nil {
+			err = s.changeStock(product, reservation.Quantity)
+			if err != nil {
+				return err
+			}
+		}
+
+		reservation.Status = model.ReservationReleased
+		reservation.UpdatedAt = currentTime
+		err = s.reservationRepository.Store(reservation)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestockProducts возвращает на склад товары из возврата заказа. Резерв заказа не трогаем:
+// возвращается только часть позиций, и по одному заказу возвратов может быть несколько
+func (s *inventoryService) RestockProducts(returnID uuid.UUID, items []model.ReservationItem) error {
+	restocks, err := s.restockRepository.FindForReturn(returnID)
+	if err != nil {
+		return err
+	}
+	if len(restocks) > 0 {
+		return nil
+	}
+
+	items, err = mergeReservationItems(items)
+	if err != nil {
+		return err
+	}
+
+	currentTime := time.Now()
+	for _, item := range items {
+		product, err := s.productRepository.Find(model.FindSpec{ProductID: &item.ProductID})
+		if err != nil && !errors.Is(err, model.ErrProductNotFound) {
+			return err
+		}
+		// удаленный товар на склад не вернуть, но возврат все равно отмечаем
+		if product != nil {
+			err = s.changeStock(product, item.Quantity)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.restockRepository.Store(model.ProductRestock{
+			ReturnID:  returnID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			CreatedAt: currentTime,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *inventoryService) changeStock(product *model.Product, delta int) error {
+	currentTime := time.Now()
+	product.Stock += delta
+	product.UpdatedAt = currentTime
+
+	err := s.productRepository.Store(*product)
+	if err != nil {
+		return err
+	}
+
+	// кричим, что остаток изменился
+	return s.eventDispatcher.Dispatch(&model.StockChanged{
+		ProductID: product.ProductID,
+		Stock:     product.Stock,
+		Delta:     delta,
+		ChangedAt: currentTime,
+	})
+}
+
+func mergeReservationItems(items []model.ReservationItem) ([]model.ReservationItem, error) {
+	merged := make([]model.ReservationItem, 0, len(items))
+	indexes := make(map[uuid.UUID]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, model.ErrInvalidReservationQuantity
+		}
+		if i, ok := indexes[item.ProductID]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		indexes[item.ProductID] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged, nil
+}
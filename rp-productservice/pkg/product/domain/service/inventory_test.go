@@ -0,0 +1,204 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"productservice/pkg/product/domain/model"
+)
+
+type MockProductReservationRepository struct {
+	mock.Mock
+}
+
+func (m *MockProductReservationRepository) Store(reservation model.ProductReservation) error {
+	args := m.Called(reservation)
+	return args.Error(0)
+}
+
+func (m *MockProductReservationRepository) FindForOrder(orderID uuid.UUID) ([]model.ProductReservation, error) {
+	args := m.Called(orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.ProductReservation), args.Error(1)
+}
+
+type MockProductRestockRepository struct {
+	mock.Mock
+}
+
+func (m *MockProductRestockRepository) Store(restock model.ProductRestock) error {
+	args := m.Called(restock)
+	return args.Error(0)
+}
+
+func (m *MockProductRestockRepository) FindForReturn(returnID uuid.UUID) ([]model.ProductRestock, error) {
+	args := m.Called(returnID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.ProductRestock), args.Error(1)
+}
+
+func TestInventoryService_AdjustStock(t *testing.T) {
+	repo := new(MockProductRepository)
+	reservationRepo := new(MockProductReservationRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewInventoryService(repo, reservationRepo, new(MockProductRestockRepository), dispatcher)
+
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Stock: 5}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(p model.Product) bool {
+			return p.ProductID == productID && p.Stock == 8
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.StockChanged) bool {
+			return e.ProductID == productID && e.Stock == 8 && e.Delta == 3
+		})).Return(nil).Once()
+
+		stock, err := service.AdjustStock(productID, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 8, stock)
+		repo.AssertExpectations(t)
+		dispatcher.AssertExpectations(t)
+	})
+
+	t.Run("negative_result", func(t *testing.T) {
+		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Stock: 2}, nil).Once()
+
+		_, err := service.AdjustStock(productID, -3)
+		assert.ErrorIs(t, err, model.ErrInsufficientStock)
+	})
+}
+
+func TestInventoryService_ReserveProducts(t *testing.T) {
+	productID := uuid.New()
+	orderID := uuid.New()
+
+	t.Run("success_merges_duplicates", func(t *testing.T) {
+		repo := new(MockProductRepository)
+		reservationRepo := new(MockProductReservationRepository)
+		dispatcher := new(MockEventDispatcher)
+		service := NewInventoryService(repo, reservationRepo, new(MockProductRestockRepository), dispatcher)
+
+		reservationRepo.On("FindForOrder", orderID).Return([]model.ProductReservation{}, nil).Once()
+		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Stock: 10}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(p model.Product) bool {
+			return p.Stock == 7
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.StockChanged")).Return(nil).Once()
+		reservationRepo.On("Store", mock.MatchedBy(func(r model.ProductReservation) bool {
+			return r.OrderID == orderID && r.ProductID == productID && r.Quantity == 3 && r.Status == model.ReservationActive
+		})).Return(nil).Once()
+
+		err := service.ReserveProducts(orderID, []model.ReservationItem{
+			{ProductID: productID, Quantity: 1},
+			{ProductID: productID, Quantity: 2},
+		})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		reservationRepo.AssertExpectations(t)
+	})
+
+	t.Run("already_reserved", func(t *testing.T) {
+		repo := new(MockProductRepository)
+		reservationRepo := new(MockProductReservationRepository)
+		service := NewInventoryService(repo, reservationRepo, new(MockProductRestockRepository), new(MockEventDispatcher))
+
+		reservationRepo.On("FindForOrder", orderID).Return([]model.ProductReservation{
+			{OrderID: orderID, ProductID: productID, Quantity: 3},
+		}, nil).Once()
+
+		err := service.ReserveProducts(orderID, []model.ReservationItem{{ProductID: productID, Quantity: 3}})
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "Store")
+	})
+
+	t.Run("insufficient_stock", func(t *testing.T) {
+		repo := new(MockProductRepository)
+		reservationRepo := new(MockProductReservationRepository)
+		service := NewInventoryService(repo, reservationRepo, new(MockProductRestockRepository), new(MockEventDispatcher))
+
+		reservationRepo.On("FindForOrder", orderID).Return([]model.ProductReservation{}, nil).Once()
+		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Stock: 1}, nil).Once()
+
+		err := service.ReserveProducts(orderID, []model.ReservationItem{{ProductID: productID, Quantity: 3}})
+		assert.ErrorIs(t, err, model.ErrInsufficientStock)
+		repo.AssertNotCalled(t, "Store")
+	})
+}
+
+func TestInventoryService_ReleaseProducts(t *testing.T) {
+	repo := new(MockProductRepository)
+	reservationRepo := new(MockProductReservationRepository)
+	dispatcher := new(MockEventDispatcher)
+	service := NewInventoryService(repo, reservationRepo, new(MockProductRestockRepository), dispatcher)
+
+	productID := uuid.New()
+	releasedProductID := uuid.New()
+	orderID := uuid.New()
+
+	reservationRepo.On("FindForOrder", orderID).Return([]model.ProductReservation{
+		{OrderID: orderID, ProductID: productID, Quantity: 3, Status: model.ReservationActive},
+		{OrderID: orderID, ProductID: releasedProductID, Quantity: 1, Status: model.ReservationReleased},
+	}, nil).Once()
+	repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Stock: 7}, nil).Once()
+	repo.On("Store", mock.MatchedBy(func(p model.Product) bool {
+		return p.ProductID == productID && p.Stock == 10
+	})).Return(nil).Once()
+	dispatcher.On("Dispatch", mock.AnythingOfType("*model.StockChanged")).Return(nil).Once()
+	reservationRepo.On("Store", mock.MatchedBy(func(r model.ProductReservation) bool {
+		return r.ProductID == productID && r.Status == model.ReservationReleased
+	})).Return(nil).Once()
+
+	err := service.ReleaseProducts(orderID)
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	reservationRepo.AssertExpectations(t)
+}
+
+func TestInventoryService_RestockProducts(t *testing.T) {
+	productID := uuid.New()
+	returnID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		repo := new(MockProductRepository)
+		restockRepo := new(MockProductRestockRepository)
+		dispatcher := new(MockEventDispatcher)
+		service := NewInventoryService(repo, new(MockProductReservationRepository), restockRepo, dispatcher)
+
+		restockRepo.On("FindForReturn", returnID).Return([]model.ProductRestock{}, nil).Once()
+		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Stock: 4}, nil).Once()
+		repo.On("Store", mock.MatchedBy(func(p model.Product) bool {
+			return p.ProductID == productID && p.Stock == 6
+		})).Return(nil).Once()
+		dispatcher.On("Dispatch", mock.AnythingOfType("*model.StockChanged")).Return(nil).Once()
+		restockRepo.On("Store", mock.MatchedBy(func(r model.ProductRestock) bool {
+			return r.ReturnID == returnID && r.ProductID == productID && r.Quantity == 2
+		})).Return(nil).Once()
+
+		err := service.RestockProducts(returnID, []model.ReservationItem{{ProductID: productID, Quantity: 2}})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		restockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already restocked", func(t *testing.T) {
+		repo := new(MockProductRepository)
+		restockRepo := new(MockProductRestockRepository)
+		service := NewInventoryService(repo, new(MockProductReservationRepository), restockRepo, new(MockEventDispatcher))
+
+		restockRepo.On("FindForReturn", returnID).Return([]model.ProductRestock{
+			{ReturnID: returnID, ProductID: productID, Quantity: 2},
+		}, nil).Once()
+
+		err := service.RestockProducts(returnID, []model.ReservationItem{{ProductID: productID, Quantity: 2}})
+		assert.NoError(t, err)
+		repo.AssertNotCalled(t, "Store", mock.Anything)
+	})
+}
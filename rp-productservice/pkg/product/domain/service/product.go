@@ -52,6 +52,7 @@ func (s *productService) CreateProduct(name string, price int64, description *st
 		Name:        name,
 		Description: description,
 		Price:       price,
+		Version:     1,
 		CreatedAt:   currentTime,
 		UpdatedAt:   currentTime,
 	}
@@ -67,6 +68,7 @@ func (s *productService) CreateProduct(name string, price int64, description *st
 		Name:        name,
 		Description: description,
 		Price:       price,
+		Version:     product.Version,
 		CreatedAt:   currentTime,
 	})
 }
@@ -95,6 +97,7 @@ func (s *productService) UpdateProduct(productID uuid.UUID, name string, price i
 	product.Name = name
 	product.Price = price
 	product.Description = description
+	product.Version++
 	product.UpdatedAt = currentTime
 
 	err = s.productRepository.Store(*product)
@@ -104,18 +107,17 @@ func (s *productService) UpdateProduct(productID uuid.UUID, name string, price i
 
 	// кричим, что продукт обновлен
 	return s.eventDispatcher.Dispatch(&model.ProductUpdated{
-		ProductID: productID,
-		UpdatedFields: struct {
-			Name        *string
-			Description *string
-			Price       *int64
-		}{Name: &name, Description: description, Price: &price},
-		UpdatedAt: currentTime,
+		ProductID:   productID,
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       product.Price,
+		Version:     product.Version,
+		UpdatedAt:   currentTime,
 	})
 }
 
 func (s *productService) DeleteProduct(productID uuid.UUID) error {
-	_, err := s.productRepository.Find(model.FindSpec{ProductID: &productID})
+	product, err := s.productRepository.Find(model.FindSpec{ProductID: &productID})
 	if err != nil {
 		if errors.Is(err, model.ErrProductNotFound) {
 			return nil
@@ -130,6 +132,7 @@ func (s *productService) DeleteProduct(productID uuid.UUID) error {
 
 	return s.eventDispatcher.Dispatch(&model.ProductDeleted{
 		ProductID: productID,
+		Version:   product.Version + 1,
 		DeletedAt: time.Now(),
 	})
 }
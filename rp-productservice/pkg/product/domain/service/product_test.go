@@ -63,7 +63,7 @@ func TestProductService_CreateProduct(t *testing.T) {
 			return p.Name == name && p.Price == price && p.ProductID == productID
 		})).Return(nil).Once()
 		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.ProductCreated) bool {
-			return e.ProductID == productID
+			return e.ProductID == productID && e.Version == 1
 		})).Return(nil).Once()
 
 		id, err := service.CreateProduct(name, price, nil)
@@ -90,14 +90,14 @@ func TestProductService_UpdateProduct(t *testing.T) {
 	newName := "New Name"
 
 	t.Run("success", func(t *testing.T) {
-		existing := &model.Product{ProductID: productID, Name: oldName, Price: 100}
+		existing := &model.Product{ProductID: productID, Name: oldName, Price: 100, Version: 1}
 		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(existing, nil).Once()
 		repo.On("Find", model.FindSpec{Name: &newName}).Return(nil, model.ErrProductNotFound).Once()
 		repo.On("Store", mock.MatchedBy(func(p model.Product) bool {
-			return p.Name == newName && p.Price == 200
+			return p.Name == newName && p.Price == 200 && p.Version == 2
 		})).Return(nil).Once()
 		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.ProductUpdated) bool {
-			return e.ProductID == productID && *e.UpdatedFields.Name == newName
+			return e.ProductID == productID && e.Name == newName && e.Price == 200 && e.Version == 2
 		})).Return(nil).Once()
 
 		err := service.UpdateProduct(productID, newName, 200, nil)
@@ -125,10 +125,10 @@ func TestProductService_DeleteProduct(t *testing.T) {
 	productID := uuid.New()
 
 	t.Run("success", func(t *testing.T) {
-		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{}, nil).Once()
+		repo.On("Find", model.FindSpec{ProductID: &productID}).Return(&model.Product{ProductID: productID, Version: 3}, nil).Once()
 		repo.On("Delete", productID).Return(nil).Once()
 		dispatcher.On("Dispatch", mock.MatchedBy(func(e *model.ProductDeleted) bool {
-			return e.ProductID == productID
+			return e.ProductID == productID && e.Version == 4
 		})).Return(nil).Once()
 
 		err := service.DeleteProduct(productID)
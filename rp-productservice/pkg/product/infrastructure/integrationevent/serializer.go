@@ -23,32 +23,42 @@ func (s eventSerializer) Serialize(event outbox.Event) (string, error) {
 			Name:        e.Name,
 			Description: e.Description,
 			Price:       e.Price,
+			Version:     e.Version,
 			CreatedAt:   e.CreatedAt.Unix(),
 		})
 		return string(b), errors.WithStack(err)
 	case *model.ProductUpdated:
 		ie := ProductUpdated{
-			ProductID: e.ProductID.String(),
-			UpdatedAt: e.UpdatedAt.Unix(),
-		}
-		if e.UpdatedFields.Name != nil {
-			ie.UpdatedFields.Name = e.UpdatedFields.Name
-		}
-		if e.UpdatedFields.Description != nil {
-			ie.UpdatedFields.Description = e.UpdatedFields.Description
-		}
-		if e.UpdatedFields.Price != nil {
-			ie.UpdatedFields.Price = e.UpdatedFields.Price
+			ProductID:   e.ProductID.String(),
+			Name:        e.Name,
+			Description: e.Description,
+			Price:       e.Price,
+			Version:     e.Version,
+			UpdatedAt:   e.UpdatedAt.Unix(),
 		}
+		// updated_fields оставлен для потребителей, которые еще не читают снимок
+		ie.UpdatedFields.Name = &e.Name
+		ie.UpdatedFields.Description = e.Description
+		ie.UpdatedFields.Price = &e.Price
 		b, err := json.Marshal(ie)
 		return string(b), errors.WithStack(err)
 
 	case *model.ProductDeleted:
 		b, err := json.Marshal(ProductDeleted{
 			ProductID: e.ProductID.String(),
+			Version:   e.Version,
 			DeletedAt: e.DeletedAt.Unix(),
 		})
 		return string(b), errors.WithStack(err)
+
+	case *model.StockChanged:
+		b, err := json.Marshal(StockChanged{
+			ProductID: e.ProductID.String(),
+			Stock:     e.Stock,
+			Delta:     e.Delta,
+			ChangedAt: e.ChangedAt.Unix(),
+		})
+		return string(b), errors.WithStack(err)
 	default:
 		return "", errors.Errorf("unknown event %q", event.Type())
 	}
@@ -59,11 +69,16 @@ type ProductCreated struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description,omitempty"`
 	Price       int64   `json:"price"`
+	Version     int64   `json:"version"`
 	CreatedAt   int64   `json:"created_at"`
 }
 
 type ProductUpdated struct {
-	ProductID     string `json:"product_id"`
+	ProductID     string  `json:"product_id"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description,omitempty"`
+	Price         int64   `json:"price"`
+	Version       int64   `json:"version"`
 	UpdatedFields struct {
 		Name        *string `json:"name,omitempty"`
 		Description *string `json:"description,omitempty"`
@@ -74,5 +89,13 @@ type ProductUpdated struct {
 
 type ProductDeleted struct {
 	ProductID string `json:"product_id"`
+	Version   int64  `json:"version"`
 	DeletedAt int64  `json:"deleted_at"`
 }
+
+type StockChanged struct {
+	ProductID string `json:"product_id"`
+	Stock     int    `json:"stock"`
+	Delta     int    `json:"delta"`
+	ChangedAt int64  `json:"changed_at"`
+}
@@ -44,4 +44,7 @@ func NewDatabaseMigrator(
 
 var builderFunctions = []MigrationBuilderFunc{
 	NewVersion1722266004,
+	NewVersion1722266010,
+	NewVersion1722266015,
+	NewVersion1722266021,
 }
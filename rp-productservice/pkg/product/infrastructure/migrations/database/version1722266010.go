@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266010(client mysql.ClientContext) migrator.Migration {
+	return &version1722266010{
+		client: client,
+	}
+}
+
+type version1722266010 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266010) Version() int64 {
+	return 1722266010
+}
+
+func (v version1722266010) Description() string {
+	return "Add 'stock' to 'product' and create 'product_reservation' table"
+}
+
+func (v version1722266010) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE product
+			ADD COLUMN stock INT NOT NULL DEFAULT 0 AFTER price
+	`)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = v.client.ExecContext(ctx, `
+		CREATE TABLE product_reservation
+		(
+			order_id      VARCHAR(64)  NOT NULL,
+			product_id    VARCHAR(64)  NOT NULL,
+			quantity      INT          NOT NULL,
+			status        INT          NOT NULL,
+			created_at    DATETIME     NOT NULL,
+			updated_at    DATETIME     NOT NULL,
+			PRIMARY KEY (order_id, product_id),
+			INDEX product_reservation_product_id_idx (product_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci
+	`)
+	return errors.WithStack(err)
+}
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266015(client mysql.ClientContext) migrator.Migration {
+	return &version1722266015{
+		client: client,
+	}
+}
+
+type version1722266015 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266015) Version() int64 {
+	return 1722266015
+}
+
+func (v version1722266015) Description() string {
+	return "Add 'version' to 'product'"
+}
+
+func (v version1722266015) Up(ctx context.Context) error {
+	// уже созданные товары считаем первой версией
+	_, err := v.client.ExecContext(ctx, `
+		ALTER TABLE product
+			ADD COLUMN version BIGINT NOT NULL DEFAULT 1 AFTER stock
+	`)
+	return errors.WithStack(err)
+}
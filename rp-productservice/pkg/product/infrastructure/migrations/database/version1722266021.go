@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/migrator"
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/pkg/errors"
+)
+
+func NewVersion1722266021(client mysql.ClientContext) migrator.Migration {
+	return &version1722266021{
+		client: client,
+	}
+}
+
+type version1722266021 struct {
+	client mysql.ClientContext
+}
+
+func (v version1722266021) Version() int64 {
+	return 1722266021
+}
+
+func (v version1722266021) Description() string {
+	return "Create 'product_restock' table"
+}
+
+func (v version1722266021) Up(ctx context.Context) error {
+	_, err := v.client.ExecContext(ctx, `
+		CREATE TABLE product_restock
+		(
+			return_id     VARCHAR(64)  NOT NULL,
+			product_id    VARCHAR(64)  NOT NULL,
+			quantity      INT          NOT NULL,
+			created_at    DATETIME     NOT NULL,
+			PRIMARY KEY (return_id, product_id)
+		)
+			ENGINE = InnoDB
+			CHARACTER SET = utf8mb4
+			COLLATE utf8mb4_unicode_ci
+	`)
+	return errors.WithStack(err)
+}
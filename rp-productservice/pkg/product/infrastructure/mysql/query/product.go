@@ -40,12 +40,14 @@ func (p *productQueryService) FindProduct(ctx context.Context, productID uuid.UU
 		Name        string           `db:"name"`
 		Description sql.Null[string] `db:"description"`
 		Price       int64            `db:"price"`
+		Stock       int              `db:"stock"`
+		Version     int64            `db:"version"`
 	}{}
 
 	err = p.client.GetContext(
 		ctx,
 		&product,
-		`SELECT product_id, name, description, price FROM product WHERE product_id = ?`,
+		`SELECT product_id, name, description, price, stock, version FROM product WHERE product_id = ?`,
 		productID,
 	)
 	if err != nil {
@@ -60,6 +62,8 @@ func (p *productQueryService) FindProduct(ctx context.Context, productID uuid.UU
 		Name:        product.Name,
 		Description: fromSQLNull(product.Description),
 		Price:       product.Price,
+		Stock:       product.Stock,
+		Version:     product.Version,
 	}, nil
 }
 
@@ -47,17 +47,21 @@ func (p *productRepository) Store(product model.Product) (err error) {
 
 	_, err = p.client.ExecContext(p.ctx,
 		`
-	INSERT INTO product (product_id, name, description, price, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)
+	INSERT INTO product (product_id, name, description, price, stock, version, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	ON DUPLICATE KEY UPDATE
 		name=VALUES(name),
 	    description=VALUES(description),
 	    price=VALUES(price),
+	    stock=VALUES(stock),
+	    version=VALUES(version),
 	    updated_at=VALUES(updated_at)
 	`,
 		product.ProductID,
 		product.Name,
 		toSQLNull(product.Description),
 		product.Price,
+		product.Stock,
+		product.Version,
 		product.CreatedAt,
 		product.UpdatedAt,
 	)
@@ -79,6 +83,8 @@ func (p *productRepository) Find(spec model.FindSpec) (_ *model.Product, err err
 		Name        string           `db:"name"`
 		Description sql.Null[string] `db:"description"`
 		Price       int64            `db:"price"`
+		Stock       int              `db:"stock"`
+		Version     int64            `db:"version"`
 		CreatedAt   time.Time        `db:"created_at"`
 		UpdatedAt   time.Time        `db:"updated_at"`
 	}{}
@@ -87,7 +93,7 @@ func (p *productRepository) Find(spec model.FindSpec) (_ *model.Product, err err
 	err = p.client.GetContext(
 		p.ctx,
 		&product,
-		`SELECT product_id, name, description, price, created_at, updated_at FROM product WHERE `+query,
+		`SELECT product_id, name, description, price, stock, version, created_at, updated_at FROM product WHERE `+query,
 		args...,
 	)
 	if err != nil {
@@ -102,6 +108,8 @@ func (p *productRepository) Find(spec model.FindSpec) (_ *model.Product, err err
 		Name:        product.Name,
 		Description: fromSQLNull(product.Description),
 		Price:       product.Price,
+		Stock:       product.Stock,
+		Version:     product.Version,
 		CreatedAt:   product.CreatedAt,
 		UpdatedAt:   product.UpdatedAt,
 	}, nil
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"productservice/pkg/product/domain/model"
+	"productservice/pkg/product/infrastructure/metrics"
+)
+
+func NewProductReservationRepository(ctx context.Context, client mysql.ClientContext) model.ProductReservationRepository {
+	return &productReservationRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type productReservationRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *productReservationRepository) Store(reservation model.ProductReservation) (err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "product_reservation", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`
+	INSERT INTO product_reservation (order_id, product_id, quantity, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)
+	ON DUPLICATE KEY UPDATE
+		status=VALUES(status),
+	    updated_at=VALUES(updated_at)
+	`,
+		reservation.OrderID,
+		reservation.ProductID,
+		reservation.Quantity,
+		reservation.Status,
+		reservation.CreatedAt,
+		reservation.UpdatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *productReservationRepository) FindForOrder(orderID uuid.UUID) (_ []model.ProductReservation, err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "product_reservation", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var reservations []struct {
+		OrderID   uuid.UUID `db:"order_id"`
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+		Status    int       `db:"status"`
+		CreatedAt time.Time `db:"created_at"`
+		UpdatedAt time.Time `db:"updated_at"`
+	}
+	err = r.client.SelectContext(
+		r.ctx,
+		&reservations,
+		`SELECT order_id, product_id, quantity, status, created_at, updated_at FROM product_reservation WHERE order_id = ?`,
+		orderID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make([]model.ProductReservation, len(reservations))
+	for i, reservation := range reservations {
+		result[i] = model.ProductReservation{
+			OrderID:   reservation.OrderID,
+			ProductID: reservation.ProductID,
+			Quantity:  reservation.Quantity,
+			Status:    model.ReservationStatus(reservation.Status),
+			CreatedAt: reservation.CreatedAt,
+			UpdatedAt: reservation.UpdatedAt,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gitea.xscloud.ru/xscloud/golib/pkg/infrastructure/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"productservice/pkg/product/domain/model"
+	"productservice/pkg/product/infrastructure/metrics"
+)
+
+func NewProductRestockRepository(ctx context.Context, client mysql.ClientContext) model.ProductRestockRepository {
+	return &productRestockRepository{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+type productRestockRepository struct {
+	ctx    context.Context
+	client mysql.ClientContext
+}
+
+func (r *productRestockRepository) Store(restock model.ProductRestock) (err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("store", "product_restock", status).Observe(time.Since(start).Seconds())
+	}()
+
+	_, err = r.client.ExecContext(r.ctx,
+		`INSERT INTO product_restock (return_id, product_id, quantity, created_at) VALUES (?, ?, ?, ?)`,
+		restock.ReturnID,
+		restock.ProductID,
+		restock.Quantity,
+		restock.CreatedAt,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *productRestockRepository) FindForReturn(returnID uuid.UUID) (_ []model.ProductRestock, err error) {
+	start := time.Now()
+	defer func() {
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		metrics.DatabaseDuration.WithLabelValues("find", "product_restock", status).Observe(time.Since(start).Seconds())
+	}()
+
+	var restocks []struct {
+		ReturnID  uuid.UUID `db:"return_id"`
+		ProductID uuid.UUID `db:"product_id"`
+		Quantity  int       `db:"quantity"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err = r.client.SelectContext(
+		r.ctx,
+		&restocks,
+		`SELECT return_id, product_id, quantity, created_at FROM product_restock WHERE return_id = ?`,
+		returnID,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make([]model.ProductRestock, len(restocks))
+	for i, restock := range restocks {
+		result[i] = model.ProductRestock{
+			ReturnID:  restock.ReturnID,
+			ProductID: restock.ProductID,
+			Quantity:  restock.Quantity,
+			CreatedAt: restock.CreatedAt,
+		}
+	}
+	return result, nil
+}
@@ -21,3 +21,11 @@ type repositoryProvider struct {
 func (r *repositoryProvider) ProductRepository(ctx context.Context) model.ProductRepository {
 	return repository.NewProductRepository(ctx, r.client)
 }
+
+func (r *repositoryProvider) ProductReservationRepository(ctx context.Context) model.ProductReservationRepository {
+	return repository.NewProductReservationRepository(ctx, r.client)
+}
+
+func (r *repositoryProvider) ProductRestockRepository(ctx context.Context) model.ProductRestockRepository {
+	return repository.NewProductRestockRepository(ctx, r.client)
+}
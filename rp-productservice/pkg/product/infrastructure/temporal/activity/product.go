@@ -5,16 +5,23 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
 
-	"productservice/pkg/product/application/query"
+	appmodel "productservice/pkg/product/application/model"
+	"productservice/pkg/product/application/service"
+	"productservice/pkg/product/domain/model"
 )
 
-func NewProductActivities(productQueryService query.ProductQueryService) *ProductActivities {
-	return &ProductActivities{productQueryService: productQueryService}
+// insufficientStockErrorType - тип ошибки, с которым резерв не повторяется: сага заказа сразу уходит в компенсацию
+const insufficientStockErrorType = "InsufficientStock"
+
+func NewProductActivities(productService service.ProductService) *ProductActivities {
+	return &ProductActivities{productService: productService}
 }
 
 type ProductActivities struct {
-	productQueryService query.ProductQueryService
+	productService service.ProductService
 }
 
 type OrderItem struct {
@@ -22,32 +29,75 @@ type OrderItem struct {
 	Quantity  int
 }
 
-func (a *ProductActivities) ReserveProducts(ctx context.Context, items []OrderItem) (bool, error) {
-	fmt.Printf("Checking availability for %d items\n", len(items))
+func (a *ProductActivities) ReserveProducts(ctx context.Context, orderIDStr string, items []OrderItem) (bool, error) {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, fmt.Errorf("invalid order id: %s", orderIDStr)
+	}
 
-	for _, item := range items {
+	reservationItems := make([]appmodel.ReservationItem, len(items))
+	for i, item := range items {
 		pID, err := uuid.Parse(item.ProductID)
 		if err != nil {
 			return false, fmt.Errorf("invalid product id: %s", item.ProductID)
 		}
-
-		product, err := a.productQueryService.FindProduct(ctx, pID)
-		if err != nil {
-			return false, err
+		reservationItems[i] = appmodel.ReservationItem{
+			ProductID: pID,
+			Quantity:  item.Quantity,
 		}
-		if product == nil {
-			return false, fmt.Errorf("product not found: %s", item.ProductID)
+	}
+
+	fmt.Printf("Reserving %d items for order %s\n", len(items), orderIDStr)
+	err = a.productService.ReserveProducts(ctx, orderID, reservationItems)
+	if err != nil {
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return false, temporal.NewNonRetryableApplicationError(err.Error(), insufficientStockErrorType, err)
 		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (a *ProductActivities) ReleaseProducts(ctx context.Context, orderIDStr string) (bool, error) {
+	orderID, err := uuid.Parse(orderIDStr)
+	if err != nil {
+		return false, fmt.Errorf("invalid order id: %s", orderIDStr)
+	}
 
-		fmt.Printf("Product confirmed: %s\n", product.Name)
+	fmt.Printf("Releasing products reservation for order %s\n", orderIDStr)
+	err = a.productService.ReleaseProducts(ctx, orderID)
+	if err != nil {
+		return false, err
 	}
 
 	return true, nil
 }
 
-func (a *ProductActivities) ReleaseProducts(_ context.Context, items []OrderItem) (bool, error) {
-	// In a real system with 'stock' column, this would increment stock back.
-	// Since we don't have stock, we just log.
-	fmt.Printf("Releasing products reservation: %+v\n", items)
+// RestockProducts возвращает на склад товары из возврата заказа
+func (a *ProductActivities) RestockProducts(ctx context.Context, returnIDStr string, items []OrderItem) (bool, error) {
+	returnID, err := uuid.Parse(returnIDStr)
+	if err != nil {
+		return false, fmt.Errorf("invalid return id: %s", returnIDStr)
+	}
+
+	restockItems := make([]appmodel.ReservationItem, len(items))
+	for i, item := range items {
+		pID, err := uuid.Parse(item.ProductID)
+		if err != nil {
+			return false, fmt.Errorf("invalid product id: %s", item.ProductID)
+		}
+		restockItems[i] = appmodel.ReservationItem{
+			ProductID: pID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	fmt.Printf("Restocking %d items for return %s\n", len(items), returnIDStr)
+	err = a.productService.RestockProducts(ctx, returnID, restockItems)
+	if err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
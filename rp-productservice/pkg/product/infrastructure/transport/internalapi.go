@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"productservice/api/server/productinternal"
 	appmodel "productservice/pkg/product/application/model"
 	"productservice/pkg/product/application/query"
 	"productservice/pkg/product/application/service"
+	"productservice/pkg/product/domain/model"
 )
 
 func NewProductInternalAPI(
@@ -61,6 +63,10 @@ func (p *productInternalAPI) FindProduct(ctx context.Context, request *productin
 		return nil, err
 	}
 	product, err := p.productQueryService.FindProduct(ctx, productID)
+	if errors.Is(err, model.ErrProductNotFound) {
+		// отсутствие товара - не ошибка: ответ просто без product
+		return &productinternal.FindProductResponse{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +79,42 @@ func (p *productInternalAPI) FindProduct(ctx context.Context, request *productin
 			Name:        product.Name,
 			Price:       product.Price,
 			Description: product.Description,
+			Stock:       int32(product.Stock), // nolint:gosec
+			Version:     product.Version,
 		},
 	}, nil
 }
+
+func (p *productInternalAPI) SetStock(ctx context.Context, request *productinternal.SetStockRequest) (*productinternal.SetStockResponse, error) {
+	productID, err := uuid.Parse(request.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.productService.SetStock(ctx, productID, int(request.Stock))
+	if err != nil {
+		return nil, err
+	}
+
+	return &productinternal.SetStockResponse{
+		ProductID: productID.String(),
+		Stock:     request.Stock,
+	}, nil
+}
+
+func (p *productInternalAPI) AdjustStock(ctx context.Context, request *productinternal.AdjustStockRequest) (*productinternal.AdjustStockResponse, error) {
+	productID, err := uuid.Parse(request.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	stock, err := p.productService.AdjustStock(ctx, productID, int(request.Delta))
+	if err != nil {
+		return nil, err
+	}
+
+	return &productinternal.AdjustStockResponse{
+		ProductID: productID.String(),
+		Stock:     int32(stock), // nolint:gosec
+	}, nil
+}
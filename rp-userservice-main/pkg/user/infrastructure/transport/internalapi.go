@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"userservice/api/server/userinternal"
 	appmodel "userservice/pkg/user/application/model"
 	"userservice/pkg/user/application/query"
 	"userservice/pkg/user/application/service"
+	"userservice/pkg/user/domain/model"
 )
 
 func NewUserInternalAPI(
@@ -62,6 +64,10 @@ func (u userInternalAPI) FindUser(ctx context.Context, request *userinternal.Fin
 		return nil, err
 	}
 	user, err := u.userQueryService.FindUser(ctx, userID)
+	if errors.Is(err, model.ErrUserNotFound) {
+		// отсутствие пользователя - не ошибка: ответ просто без user
+		return &userinternal.FindUserResponse{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}